@@ -1,6 +1,8 @@
 package app
 
 import (
+	"fmt"
+
 	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -31,6 +33,7 @@ type ethereumBridgeApp struct {
 	keyStaking       *sdk.KVStoreKey
 	tkeyStaking      *sdk.TransientStoreKey
 	keyOracle        *sdk.KVStoreKey
+	keyEthBridge     *sdk.KVStoreKey
 	keyFeeCollection *sdk.KVStoreKey
 	keyParams        *sdk.KVStoreKey
 	tkeyParams       *sdk.TransientStoreKey
@@ -40,12 +43,20 @@ type ethereumBridgeApp struct {
 	feeCollectionKeeper auth.FeeCollectionKeeper
 	stakingKeeper       staking.Keeper
 
-	paramsKeeper params.Keeper
-	oracleKeeper oracle.Keeper
+	paramsKeeper    params.Keeper
+	oracleKeeper    oracle.Keeper
+	ethBridgeKeeper ethbridge.Keeper
+
+	// bridgeContracts maps each ethereum chain id to the address of its bridge contract, used to migrate
+	// prophecies stored before their ids included the contract
+	bridgeContracts map[int]string
+
+	// invCheckPeriod is the number of blocks between checks of the invariants, or 0 to never check them
+	invCheckPeriod uint
 }
 
 // NewEthereumBridgeApp is a constructor function for ethereumBridgeApp
-func NewEthereumBridgeApp(logger log.Logger, db dbm.DB) *ethereumBridgeApp {
+func NewEthereumBridgeApp(logger log.Logger, db dbm.DB, bridgeContracts map[int]string, invCheckPeriod uint) *ethereumBridgeApp {
 
 	// First define the top level codec that will be shared by the different modules
 	cdc := MakeCodec()
@@ -63,9 +74,13 @@ func NewEthereumBridgeApp(logger log.Logger, db dbm.DB) *ethereumBridgeApp {
 		keyStaking:       sdk.NewKVStoreKey(staking.StoreKey),
 		tkeyStaking:      sdk.NewTransientStoreKey(staking.TStoreKey),
 		keyOracle:        sdk.NewKVStoreKey(oracle.StoreKey),
+		keyEthBridge:     sdk.NewKVStoreKey(ethbridge.StoreKey),
 		keyFeeCollection: sdk.NewKVStoreKey(auth.FeeStoreKey),
 		keyParams:        sdk.NewKVStoreKey(params.StoreKey),
 		tkeyParams:       sdk.NewTransientStoreKey(params.TStoreKey),
+
+		bridgeContracts: bridgeContracts,
+		invCheckPeriod:  invCheckPeriod,
 	}
 
 	// The ParamsKeeper handles parameter storage for the application
@@ -110,6 +125,14 @@ func NewEthereumBridgeApp(logger log.Logger, db dbm.DB) *ethereumBridgeApp {
 	}
 	app.oracleKeeper = oracleKeeper
 
+	// The EthBridgeKeeper handles interactions with the ethbridge's own store
+	app.ethBridgeKeeper = ethbridge.NewKeeper(
+		app.keyEthBridge,
+		app.cdc,
+		app.paramsKeeper.Subspace(ethbridge.DefaultParamspace),
+		ethbridge.DefaultCodespace,
+	)
+
 	// The AnteHandler handles signature verification and transaction pre-processing
 	app.SetAnteHandler(auth.NewAnteHandler(app.accountKeeper, app.feeCollectionKeeper))
 
@@ -118,13 +141,13 @@ func NewEthereumBridgeApp(logger log.Logger, db dbm.DB) *ethereumBridgeApp {
 	app.Router().
 		AddRoute(bank.RouterKey, bank.NewHandler(app.bankKeeper)).
 		AddRoute(staking.RouterKey, staking.NewHandler(app.stakingKeeper)).
-		AddRoute(ethbridge.RouterKey, ethbridge.NewHandler(app.oracleKeeper, app.bankKeeper, app.cdc, ethbridge.DefaultCodespace))
+		AddRoute(ethbridge.RouterKey, ethbridge.NewHandler(app.ethBridgeKeeper, app.oracleKeeper, app.bankKeeper, app.cdc, ethbridge.DefaultCodespace))
 
 	// The app.QueryRouter is the main query router where each module registers its routes
 	app.QueryRouter().
 		AddRoute(auth.QuerierRoute, auth.NewQuerier(app.accountKeeper)).
 		AddRoute(staking.QuerierRoute, staking.NewQuerier(app.stakingKeeper, app.cdc)).
-		AddRoute(ethbridge.QuerierRoute, ethbridge.NewQuerier(app.oracleKeeper, app.cdc, ethbridge.DefaultCodespace))
+		AddRoute(ethbridge.QuerierRoute, ethbridge.NewQuerier(app.ethBridgeKeeper, app.oracleKeeper, app.cdc, ethbridge.DefaultCodespace))
 
 	// The initChainer handles translating the genesis.json file into initial state for the network
 	app.SetInitChainer(app.initChainer)
@@ -134,12 +157,14 @@ func NewEthereumBridgeApp(logger log.Logger, db dbm.DB) *ethereumBridgeApp {
 		app.keyAccount,
 		app.keyStaking,
 		app.keyOracle,
+		app.keyEthBridge,
 		app.keyFeeCollection,
 		app.keyParams,
 		app.tkeyParams,
 		app.tkeyStaking,
 	)
 
+	app.SetBeginBlocker(app.BeginBlocker)
 	app.SetEndBlocker(app.EndBlocker)
 
 	err := app.LoadLatestVersion(app.keyMain)
@@ -169,6 +194,7 @@ func (app *ethereumBridgeApp) initFromGenesisState(ctx sdk.Context, genesisState
 	// initialize module-specific stores
 	auth.InitGenesis(ctx, app.accountKeeper, app.feeCollectionKeeper, genesisState.AuthData)
 	bank.InitGenesis(ctx, app.bankKeeper, genesisState.BankData)
+	ethbridge.InitGenesis(ctx, app.ethBridgeKeeper, genesisState.EthBridgeData)
 
 	if len(genesisState.GenTxs) > 0 {
 		for _, genTx := range genesisState.GenTxs {
@@ -218,13 +244,39 @@ func MakeCodec() *codec.Codec {
 	return cdc
 }
 
+// application updates every begin block
+func (app *ethereumBridgeApp) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+	ethbridge.BeginBlocker(ctx, app.ethBridgeKeeper, app.oracleKeeper, app.accountKeeper, app.feeCollectionKeeper, app.bridgeContracts)
+
+	return abci.ResponseBeginBlock{}
+}
+
 // application updates every end block
 // nolint: unparam
 func (app *ethereumBridgeApp) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) abci.ResponseEndBlock {
-	validatorUpdates, _ := staking.EndBlocker(ctx, app.stakingKeeper)
+	validatorUpdates, tags := staking.EndBlocker(ctx, app.stakingKeeper)
+	tags = tags.AppendTags(ethbridge.EndBlocker(ctx, app.ethBridgeKeeper, app.oracleKeeper, app.bankKeeper))
+
+	if app.invCheckPeriod != 0 && ctx.BlockHeight()%int64(app.invCheckPeriod) == 0 {
+		app.assertRuntimeInvariants(ctx)
+	}
 
 	return abci.ResponseEndBlock{
 		ValidatorUpdates: validatorUpdates,
+		Tags:             tags,
+	}
+}
+
+// assertRuntimeInvariants halts the chain if an invariant is broken, before more state is built on it
+func (app *ethereumBridgeApp) assertRuntimeInvariants(ctx sdk.Context) {
+	invariants := []sdk.Invariant{
+		ethbridge.SupplyInvariant(app.ethBridgeKeeper, app.accountKeeper, app.feeCollectionKeeper),
+		ethbridge.RelayerRewardsInvariant(app.ethBridgeKeeper, app.accountKeeper),
+	}
+	for _, invariant := range invariants {
+		if err := invariant(ctx); err != nil {
+			panic(fmt.Errorf("invariant broken: %s", err))
+		}
 	}
 }
 
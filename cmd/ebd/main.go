@@ -7,6 +7,7 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -26,6 +27,8 @@ import (
 	"github.com/tendermint/tendermint/types"
 
 	app "github.com/swishlabsco/cosmos-ethereum-bridge"
+	"github.com/swishlabsco/cosmos-ethereum-bridge/x/ethbridge"
+	ethbridgeCommon "github.com/swishlabsco/cosmos-ethereum-bridge/x/ethbridge/common"
 
 	gaiaInit "github.com/cosmos/cosmos-sdk/cmd/gaia/init"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -44,6 +47,9 @@ const (
 	flagVestingStart = "vesting-start-time"
 	flagVestingEnd   = "vesting-end-time"
 	flagVestingAmt   = "vesting-amount"
+
+	flagBridgeContracts = "bridge-contracts"
+	flagInvCheckPeriod  = "inv-check-period"
 )
 
 func main() {
@@ -62,9 +68,22 @@ func main() {
 	rootCmd.AddCommand(AddGenesisAccountCmd(ctx, cdc))
 	server.AddCommands(ctx, cdc, rootCmd, newApp, exportAppStateAndTMValidators)
 
+	rootCmd.PersistentFlags().String(flagBridgeContracts, "",
+		"bridge contract address of each ethereum chain, as chain-id=address pairs separated by commas, "+
+			"needed to migrate prophecies stored before prophecy ids included the contract")
+	err := viper.BindPFlag(flagBridgeContracts, rootCmd.PersistentFlags().Lookup(flagBridgeContracts))
+	if err != nil {
+		panic(err)
+	}
+	rootCmd.PersistentFlags().Int(flagInvCheckPeriod, 0, "check the invariants every given number of blocks, halting the chain if one is broken")
+	err = viper.BindPFlag(flagInvCheckPeriod, rootCmd.PersistentFlags().Lookup(flagInvCheckPeriod))
+	if err != nil {
+		panic(err)
+	}
+
 	// prepare and add flags
 	executor := cli.PrepareBaseCmd(rootCmd, "EB", DefaultNodeHome)
-	err := executor.Execute()
+	err = executor.Execute()
 	if err != nil {
 		// handle with #870
 		panic(err)
@@ -72,7 +91,40 @@ func main() {
 }
 
 func newApp(logger log.Logger, db dbm.DB, traceStore io.Writer) abci.Application {
-	return app.NewEthereumBridgeApp(logger, db)
+	bridgeContracts, err := parseBridgeContracts(viper.GetString(flagBridgeContracts))
+	if err != nil {
+		common.Exit(err.Error())
+	}
+	invCheckPeriod := viper.GetInt(flagInvCheckPeriod)
+	if invCheckPeriod < 0 {
+		common.Exit(fmt.Sprintf("invalid %s %d, expected a number of blocks which is not negative", flagInvCheckPeriod, invCheckPeriod))
+	}
+	return app.NewEthereumBridgeApp(logger, db, bridgeContracts, uint(invCheckPeriod))
+}
+
+// parseBridgeContracts parses the bridge contract address of each ethereum chain from a list of chain-id=address
+// pairs separated by commas
+func parseBridgeContracts(s string) (map[int]string, error) {
+	bridgeContracts := make(map[int]string)
+	for _, pair := range strings.Split(s, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		parts := strings.SplitN(pair, "=", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid bridge contract %q, expected chain-id=address", pair)
+		}
+		ethereumChainID, err := strconv.Atoi(parts[0])
+		if err != nil || ethereumChainID <= 0 {
+			return nil, fmt.Errorf("invalid ethereum chain id in bridge contract %q", pair)
+		}
+		if !ethbridgeCommon.IsValidEthAddress(parts[1]) {
+			return nil, fmt.Errorf("invalid contract address in bridge contract %q", pair)
+		}
+		bridgeContracts[ethereumChainID] = parts[1]
+	}
+	return bridgeContracts, nil
 }
 
 func exportAppStateAndTMValidators(
@@ -80,14 +132,14 @@ func exportAppStateAndTMValidators(
 ) (json.RawMessage, []tmtypes.GenesisValidator, error) {
 
 	if height != -1 {
-		ebApp := app.NewEthereumBridgeApp(logger, db)
+		ebApp := app.NewEthereumBridgeApp(logger, db, nil, 0)
 		err := ebApp.LoadHeight(height)
 		if err != nil {
 			return nil, nil, err
 		}
 		return ebApp.ExportAppStateAndValidators(forZeroHeight, jailWhiteList)
 	}
-	ebApp := app.NewEthereumBridgeApp(logger, db)
+	ebApp := app.NewEthereumBridgeApp(logger, db, nil, 0)
 	return ebApp.ExportAppStateAndValidators(forZeroHeight, jailWhiteList)
 }
 
@@ -119,9 +171,10 @@ func InitCmd(ctx *server.Context, cdc *codec.Codec) *cobra.Command {
 			}
 
 			genesis := app.GenesisState{
-				AuthData:    auth.DefaultGenesisState(),
-				BankData:    bank.DefaultGenesisState(),
-				StakingData: staking.DefaultGenesisState(),
+				AuthData:      auth.DefaultGenesisState(),
+				BankData:      bank.DefaultGenesisState(),
+				StakingData:   staking.DefaultGenesisState(),
+				EthBridgeData: ethbridge.DefaultGenesisState(),
 			}
 
 			appState, err = codec.MarshalJSONIndent(cdc, genesis)
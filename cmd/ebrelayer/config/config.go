@@ -0,0 +1,394 @@
+package config
+
+// ------------------------------------------------------------
+//    Config
+//
+//    Loads and validates the relayer's configuration file,
+//    which describes the Cosmos connection and a watcher
+//    for each Ethereum network with a Peggy deployment.
+// ------------------------------------------------------------
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"text/template"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/mitchellh/mapstructure"
+	"github.com/spf13/viper"
+	tmflags "github.com/tendermint/tendermint/libs/cli/flags"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+const (
+	DefaultNode          = "tcp://localhost:26657"
+	DefaultConfirmations = 6
+	DefaultWatcherName   = "ropsten"
+	RopstenChainID       = 3
+
+	// DefaultLogLevel is the level of modules without a level of their own in the log level setting
+	DefaultLogLevel = "info"
+	// LogFormatPlain writes each log line as key=value pairs
+	LogFormatPlain = "plain"
+	// LogFormatJSON writes each log line as a JSON object
+	LogFormatJSON = "json"
+
+	// DefaultMetricsListenAddress is where /metrics and /health are served, only on the loopback interface
+	DefaultMetricsListenAddress = "127.0.0.1:26661"
+
+	// SignerKeyring unlocks the validator's key from the keybase once on start-up
+	SignerKeyring = "keyring"
+	// SignerRemote signs through a separate signer process listening on a unix socket
+	SignerRemote = "remote"
+)
+
+var (
+	// DefaultRelayerHome is the folder where the relayer's configuration is stored
+	DefaultRelayerHome = os.ExpandEnv("$HOME/.ebrelayer")
+	// DefaultConfigFile is the default location of the relayer's configuration file
+	DefaultConfigFile = filepath.Join(DefaultRelayerHome, "config.toml")
+	// DefaultCLIHome is the folder holding the keybase of the validator running the relayer
+	DefaultCLIHome = os.ExpandEnv("$HOME/.ebcli")
+)
+
+// Config is the relayer's configuration
+type Config struct {
+	Cosmos   CosmosConfig     `mapstructure:"cosmos"`
+	Ethereum []EthereumConfig `mapstructure:"ethereum"`
+	Log      LogConfig        `mapstructure:"log"`
+	Metrics  MetricsConfig    `mapstructure:"metrics"`
+}
+
+// CosmosConfig describes the Cosmos node claims are relayed to and the validator key which signs them
+type CosmosConfig struct {
+	Node      string `mapstructure:"node"`
+	ChainID   string `mapstructure:"chain_id"`
+	TrustNode bool   `mapstructure:"trust_node"`
+	Key       string `mapstructure:"key"`
+	Home      string `mapstructure:"home"`
+
+	// Signer is how the validator's key is accessed, either SignerKeyring or SignerRemote
+	Signer string `mapstructure:"signer"`
+	// PassphraseFile and PassphraseEnv are read instead of prompting for the keyring passphrase
+	PassphraseFile string `mapstructure:"passphrase_file"`
+	PassphraseEnv  string `mapstructure:"passphrase_env"`
+	// SignerSocket is the unix socket of the remote signer
+	SignerSocket string `mapstructure:"signer_socket"`
+}
+
+// EthereumConfig describes a watcher of one Ethereum network: its providers and the Peggy contract which is
+// watched for events
+type EthereumConfig struct {
+	Name            string   `mapstructure:"name"`
+	ChainID         int      `mapstructure:"chain_id"`
+	Providers       []string `mapstructure:"providers"`
+	ContractAddress string   `mapstructure:"contract_address"`
+	StartBlock      uint64   `mapstructure:"start_block"`
+	Confirmations   uint64   `mapstructure:"confirmations"`
+
+	// Verify checks each lock against the receipt of its transaction and the item held by the contract before
+	// claiming it, and claims the pending prophecies of other validators once they are verified
+	Verify bool `mapstructure:"verify"`
+}
+
+// LogConfig describes the relayer's log output
+type LogConfig struct {
+	// Level is a level for all modules, such as "info", or a level for each module, such as "txs:debug,*:info"
+	Level string `mapstructure:"level"`
+	// Format is LogFormatPlain or LogFormatJSON
+	Format string `mapstructure:"format"`
+}
+
+// MetricsConfig describes where the relayer's Prometheus metrics and health report are served
+type MetricsConfig struct {
+	// ListenAddress is the TCP address to listen on, or empty to disable the endpoints
+	ListenAddress string `mapstructure:"listen_address"`
+}
+
+// DefaultConfig returns a config with default values, to be completed by the operator
+func DefaultConfig() Config {
+	return Config{
+		Cosmos: CosmosConfig{
+			Node:      DefaultNode,
+			ChainID:   "testing",
+			TrustNode: false,
+			Key:       "validator",
+			Home:      DefaultCLIHome,
+			Signer:    SignerKeyring,
+		},
+		Ethereum: []EthereumConfig{
+			{
+				Name:            DefaultWatcherName,
+				ChainID:         RopstenChainID,
+				Providers:       []string{"wss://ropsten.infura.io/ws"},
+				ContractAddress: "0x3de4ef81Ba6243A60B0a32d3BCeD4173b6EA02bb",
+				StartBlock:      0,
+				Confirmations:   DefaultConfirmations,
+			},
+		},
+		Log: LogConfig{
+			Level:  DefaultLogLevel,
+			Format: LogFormatPlain,
+		},
+		Metrics: MetricsConfig{
+			ListenAddress: DefaultMetricsListenAddress,
+		},
+	}
+}
+
+// defaultEthereumConfig holds the settings a watcher keeps when they are omitted from the config file
+func defaultEthereumConfig() EthereumConfig {
+	return EthereumConfig{
+		Confirmations: DefaultConfirmations,
+	}
+}
+
+// LoadConfig reads a TOML or YAML config file, determined by its extension, and validates it
+func LoadConfig(path string) (Config, error) {
+	v := viper.New()
+	v.SetConfigFile(path)
+
+	if err := v.ReadInConfig(); err != nil {
+		return Config{}, fmt.Errorf("failed to read config file %s: %v", path, err)
+	}
+
+	cfg := DefaultConfig()
+	if err := v.Unmarshal(&cfg); err != nil {
+		return Config{}, fmt.Errorf("failed to parse config file %s: %v", path, err)
+	}
+
+	// Watchers are decoded one by one so that each starts from the watcher defaults, rather than
+	// from the watcher at the same position in the default config
+	watchers, err := decodeWatchers(v.Get("ethereum"))
+	if err != nil {
+		return Config{}, fmt.Errorf("failed to parse config file %s: %v", path, err)
+	}
+	cfg.Ethereum = watchers
+
+	if err := cfg.ValidateBasic(); err != nil {
+		return Config{}, err
+	}
+	return cfg, nil
+}
+
+// ValidateBasic runs stateless checks on the config
+func (cfg Config) ValidateBasic() error {
+	if cfg.Cosmos.ChainID == "" {
+		return fmt.Errorf("invalid cosmos.chain_id: must not be empty")
+	}
+	switch cfg.Cosmos.Signer {
+	case SignerKeyring:
+		if cfg.Cosmos.Key == "" {
+			return fmt.Errorf("invalid cosmos.key: must not be empty")
+		}
+		if cfg.Cosmos.PassphraseFile != "" && cfg.Cosmos.PassphraseEnv != "" {
+			return fmt.Errorf("invalid cosmos.passphrase_file: only one of passphrase_file and passphrase_env can be set")
+		}
+	case SignerRemote:
+		if cfg.Cosmos.SignerSocket == "" {
+			return fmt.Errorf("invalid cosmos.signer_socket: must not be empty for a remote signer")
+		}
+	default:
+		return fmt.Errorf("invalid cosmos.signer: must be %s or %s, got %v", SignerKeyring, SignerRemote, cfg.Cosmos.Signer)
+	}
+	if _, err := url.Parse(cfg.Cosmos.Node); err != nil || cfg.Cosmos.Node == "" {
+		return fmt.Errorf("invalid cosmos.node: %v", cfg.Cosmos.Node)
+	}
+
+	if len(cfg.Ethereum) == 0 {
+		return fmt.Errorf("invalid ethereum: at least one watcher is required")
+	}
+	names := make(map[string]bool)
+	chainIDs := make(map[int]bool)
+	for i, watcher := range cfg.Ethereum {
+		if err := watcher.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid ethereum[%d]: %v", i, err)
+		}
+		if names[watcher.Name] {
+			return fmt.Errorf("invalid ethereum[%d].name: %v is used by another watcher", i, watcher.Name)
+		}
+		names[watcher.Name] = true
+
+		// Prophecy ids are unique per chain, so two watchers on the same chain would relay colliding claims
+		if chainIDs[watcher.ChainID] {
+			return fmt.Errorf("invalid ethereum[%d].chain_id: %v is watched by another watcher", i, watcher.ChainID)
+		}
+		chainIDs[watcher.ChainID] = true
+	}
+
+	if _, err := tmflags.ParseLogLevel(cfg.Log.Level, log.NewNopLogger(), DefaultLogLevel); err != nil {
+		return fmt.Errorf("invalid log.level: %v", err)
+	}
+	if cfg.Log.Format != LogFormatPlain && cfg.Log.Format != LogFormatJSON {
+		return fmt.Errorf("invalid log.format: must be %s or %s, got %v", LogFormatPlain, LogFormatJSON, cfg.Log.Format)
+	}
+
+	if cfg.Metrics.ListenAddress != "" {
+		if _, _, err := net.SplitHostPort(cfg.Metrics.ListenAddress); err != nil {
+			return fmt.Errorf("invalid metrics.listen_address: %v", err)
+		}
+	}
+	return nil
+}
+
+// ValidateBasic runs stateless checks on a watcher's config
+func (watcher EthereumConfig) ValidateBasic() error {
+	if watcher.Name == "" {
+		return fmt.Errorf("invalid name: must not be empty")
+	}
+	if watcher.ChainID <= 0 {
+		return fmt.Errorf("invalid chain_id: must be > 0")
+	}
+	if len(watcher.Providers) == 0 {
+		return fmt.Errorf("invalid providers: at least one provider is required")
+	}
+	for _, provider := range watcher.Providers {
+		if !isWebsocketURL(provider) {
+			return fmt.Errorf("invalid providers: %v is not a websocket URL", provider)
+		}
+	}
+	if !common.IsHexAddress(watcher.ContractAddress) {
+		return fmt.Errorf("invalid contract_address: %v", watcher.ContractAddress)
+	}
+	return nil
+}
+
+// decodeWatchers decodes the raw ethereum section of a config file into watcher configs. A single
+// [ethereum] table is decoded as one watcher.
+func decodeWatchers(raw interface{}) ([]EthereumConfig, error) {
+	if raw == nil {
+		return nil, nil
+	}
+
+	var items []interface{}
+	if err := decodeWeak(raw, &items); err != nil {
+		return nil, err
+	}
+
+	watchers := make([]EthereumConfig, len(items))
+	for i, item := range items {
+		watchers[i] = defaultEthereumConfig()
+		if err := decodeWeak(item, &watchers[i]); err != nil {
+			return nil, fmt.Errorf("ethereum[%d]: %v", i, err)
+		}
+	}
+	return watchers, nil
+}
+
+// decodeWeak decodes with the same weak typing viper uses when unmarshaling
+func decodeWeak(input interface{}, result interface{}) error {
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		Result:           result,
+		WeaklyTypedInput: true,
+	})
+	if err != nil {
+		return err
+	}
+	return decoder.Decode(input)
+}
+
+func isWebsocketURL(rawurl string) bool {
+	u, err := url.Parse(rawurl)
+	if err != nil {
+		return false
+	}
+	return u.Scheme == "ws" || u.Scheme == "wss"
+}
+
+// WriteConfigFile renders the config as TOML and writes it to the given path
+func WriteConfigFile(path string, cfg Config) error {
+	var buffer bytes.Buffer
+	if err := configTemplate.Execute(&buffer, cfg); err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, buffer.Bytes(), 0600)
+}
+
+var configTemplate = template.Must(template.New("relayerConfig").Parse(defaultConfigTemplate))
+
+const defaultConfigTemplate = `# This is a TOML config file for the ethereum bridge relayer.
+# For more information, see https://github.com/toml-lang/toml
+
+##### cosmos configuration options #####
+[cosmos]
+
+# TCP or UNIX socket address of the Tendermint node claims are relayed to
+node = "{{ .Cosmos.Node }}"
+
+# Chain ID of the Cosmos bridge chain
+chain_id = "{{ .Cosmos.ChainID }}"
+
+# Trust the node's responses instead of verifying proofs
+trust_node = {{ .Cosmos.TrustNode }}
+
+# Name of the validator's key used to sign claims
+key = "{{ .Cosmos.Key }}"
+
+# Directory holding the validator's keybase
+home = "{{ .Cosmos.Home }}"
+
+# How claims are signed: "keyring" unlocks the key from the keybase once on start-up,
+# "remote" signs through a signer process listening on signer_socket
+signer = "{{ .Cosmos.Signer }}"
+
+# File or environment variable holding the keyring passphrase, to run without a terminal.
+# If neither is set, the passphrase is prompted for. The file must only be accessible by its owner.
+passphrase_file = "{{ .Cosmos.PassphraseFile }}"
+passphrase_env = "{{ .Cosmos.PassphraseEnv }}"
+
+# Unix socket of the remote signer
+signer_socket = "{{ .Cosmos.SignerSocket }}"
+
+##### ethereum configuration options #####
+# Add an [[ethereum]] watcher for each network with a Peggy deployment to be relayed
+{{ range .Ethereum }}
+[[ethereum]]
+
+# Name of the watcher, shown in the relayer's output
+name = "{{ .Name }}"
+
+# Chain ID of the Ethereum network, included in every claim so that prophecy ids are unique across networks
+chain_id = {{ .ChainID }}
+
+# Websocket web3 providers, tried in order until one connects
+providers = [{{ range $i, $provider := .Providers }}{{ if $i }}, {{ end }}"{{ $provider }}"{{ end }}]
+
+# Address of the deployed Peggy contract
+contract_address = "{{ .ContractAddress }}"
+
+# Block to replay events from on start-up, 0 to only watch new events
+start_block = {{ .StartBlock }}
+
+# Number of blocks an event must be buried under before it is relayed
+confirmations = {{ .Confirmations }}
+
+# Verify each lock against its transaction receipt and the item held by the contract before claiming it,
+# and claim the pending prophecies started by other validators once their locks are verified
+verify = {{ .Verify }}
+{{ end }}
+##### log configuration options #####
+[log]
+
+# Log level for all modules, such as "info", or for each module, such as "txs:debug,*:info".
+# The relayer's modules are relayer, events, txs and contract.
+level = "{{ .Log.Level }}"
+
+# Log format, "plain" or "json"
+format = "{{ .Log.Format }}"
+
+##### metrics configuration options #####
+[metrics]
+
+# Address Prometheus metrics are served on at /metrics, and the health of the relayer's chain
+# connections at /health. Leave empty to disable.
+listen_address = "{{ .Metrics.ListenAddress }}"
+`
@@ -0,0 +1,155 @@
+package config
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteAndLoadConfig(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ebrelayer")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "config.toml")
+	cfg := DefaultConfig()
+	cfg.Ethereum[0].Providers = []string{"ws://localhost:8545", "wss://ropsten.infura.io/ws"}
+	cfg.Ethereum[0].StartBlock = 42
+	cfg.Cosmos.PassphraseEnv = "EBRELAYER_PASSPHRASE"
+	cfg.Metrics.ListenAddress = ":9464"
+	cfg.Log.Level = "txs:debug,*:info"
+	cfg.Log.Format = LogFormatJSON
+	cfg.Ethereum = append(cfg.Ethereum, EthereumConfig{
+		Name:            "poa",
+		ChainID:         1337,
+		Providers:       []string{"ws://10.0.0.5:8546"},
+		ContractAddress: "0x7B95B6EC7EbD73572298cEf32Bb54FA408207359",
+		Confirmations:   1,
+		Verify:          true,
+	})
+
+	require.NoError(t, WriteConfigFile(path, cfg))
+
+	loaded, err := LoadConfig(path)
+	require.NoError(t, err)
+	require.Equal(t, cfg, loaded)
+}
+
+func TestLoadYAMLConfig(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ebrelayer")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "config.yaml")
+	yaml := `
+cosmos:
+  chain_id: bridge
+  key: relayer
+ethereum:
+  - name: local
+    chain_id: 5777
+    providers:
+      - ws://localhost:8545
+    contract_address: "0x3de4ef81Ba6243A60B0a32d3BCeD4173b6EA02bb"
+    confirmations: 12
+  - name: rinkeby
+    chain_id: 4
+    providers:
+      - wss://rinkeby.infura.io/ws
+    contract_address: "0x7B95B6EC7EbD73572298cEf32Bb54FA408207359"
+`
+	require.NoError(t, ioutil.WriteFile(path, []byte(yaml), 0600))
+
+	loaded, err := LoadConfig(path)
+	require.NoError(t, err)
+	require.Equal(t, "bridge", loaded.Cosmos.ChainID)
+	require.Equal(t, DefaultNode, loaded.Cosmos.Node)
+	require.Equal(t, DefaultMetricsListenAddress, loaded.Metrics.ListenAddress)
+	require.Equal(t, DefaultLogLevel, loaded.Log.Level)
+	require.Len(t, loaded.Ethereum, 2)
+	require.Equal(t, 5777, loaded.Ethereum[0].ChainID)
+	require.Equal(t, uint64(12), loaded.Ethereum[0].Confirmations)
+
+	// Omitted settings take the watcher defaults rather than those of the default config's watcher
+	require.Equal(t, "rinkeby", loaded.Ethereum[1].Name)
+	require.Equal(t, uint64(DefaultConfirmations), loaded.Ethereum[1].Confirmations)
+	require.Equal(t, []string{"wss://rinkeby.infura.io/ws"}, loaded.Ethereum[1].Providers)
+}
+
+func TestValidateBasic(t *testing.T) {
+	require.NoError(t, DefaultConfig().ValidateBasic())
+
+	cfg := DefaultConfig()
+	cfg.Cosmos.ChainID = ""
+	require.Error(t, cfg.ValidateBasic())
+
+	cfg = DefaultConfig()
+	cfg.Cosmos.PassphraseFile = "/run/credentials/ebrelayer/passphrase"
+	require.NoError(t, cfg.ValidateBasic())
+	cfg.Cosmos.PassphraseEnv = "EBRELAYER_PASSPHRASE"
+	require.Error(t, cfg.ValidateBasic())
+
+	// A remote signer needs its socket, but not a key name
+	cfg = DefaultConfig()
+	cfg.Cosmos.Signer = SignerRemote
+	cfg.Cosmos.Key = ""
+	require.Error(t, cfg.ValidateBasic())
+	cfg.Cosmos.SignerSocket = "/run/ebrelayer/signer.sock"
+	require.NoError(t, cfg.ValidateBasic())
+
+	cfg = DefaultConfig()
+	cfg.Cosmos.Signer = "ledger"
+	require.Error(t, cfg.ValidateBasic())
+
+	cfg = DefaultConfig()
+	cfg.Log.Level = "txs:verbose"
+	require.Error(t, cfg.ValidateBasic())
+	cfg.Log.Level = "relayer:debug,*:error"
+	require.NoError(t, cfg.ValidateBasic())
+	cfg.Log.Format = "logfmt"
+	require.Error(t, cfg.ValidateBasic())
+
+	// Metrics can be disabled, but not served on an address without a port
+	cfg = DefaultConfig()
+	cfg.Metrics.ListenAddress = ""
+	require.NoError(t, cfg.ValidateBasic())
+	cfg.Metrics.ListenAddress = "localhost"
+	require.Error(t, cfg.ValidateBasic())
+
+	cfg = DefaultConfig()
+	cfg.Ethereum = nil
+	require.Error(t, cfg.ValidateBasic())
+
+	cfg = DefaultConfig()
+	cfg.Ethereum[0].Providers = []string{"https://ropsten.infura.io"}
+	require.Error(t, cfg.ValidateBasic())
+
+	cfg = DefaultConfig()
+	cfg.Ethereum[0].ContractAddress = "3de4ef81"
+	require.Error(t, cfg.ValidateBasic())
+
+	cfg = DefaultConfig()
+	cfg.Ethereum[0].ChainID = 0
+	require.Error(t, cfg.ValidateBasic())
+
+	cfg = DefaultConfig()
+	cfg.Ethereum[0].Name = ""
+	require.Error(t, cfg.ValidateBasic())
+
+	// Watchers must have distinct names and chains
+	cfg = DefaultConfig()
+	second := cfg.Ethereum[0]
+	second.ChainID = 4
+	cfg.Ethereum = append(cfg.Ethereum, second)
+	require.Error(t, cfg.ValidateBasic())
+
+	second.Name = "rinkeby"
+	cfg.Ethereum[1] = second
+	require.NoError(t, cfg.ValidateBasic())
+
+	cfg.Ethereum[1].ChainID = cfg.Ethereum[0].ChainID
+	require.Error(t, cfg.ValidateBasic())
+}
@@ -7,25 +7,131 @@ package contract
 // -------------------------------------------------------
 
 import (
-	"io/ioutil"
-	"log"
+	"context"
+	"fmt"
+	"math/big"
+	"os"
 	"strings"
 
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/tendermint/tendermint/libs/log"
 )
 
-func LoadABI() abi.ABI {
-	// Open the file containing Peggy contract's ABI
-	rawContractAbi, errorMsg := ioutil.ReadFile("cmd/ebrelayer/contract/PeggyABI.json")
-	if errorMsg != nil {
-		log.Fatal(errorMsg)
+// LoadABI parses the Peggy ABI compiled into the relayer
+func LoadABI() (abi.ABI, error) {
+	contractAbi, err := abi.JSON(strings.NewReader(PeggyABI))
+	if err != nil {
+		return abi.ABI{}, fmt.Errorf("failed to parse embedded Peggy ABI: %v", err)
+	}
+	return contractAbi, nil
+}
+
+// LoadABIFromFile parses an alternate contract ABI from a JSON file
+func LoadABIFromFile(path string) (abi.ABI, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return abi.ABI{}, err
+	}
+	defer file.Close()
+
+	contractAbi, err := abi.JSON(file)
+	if err != nil {
+		return abi.ABI{}, fmt.Errorf("failed to parse ABI file %s: %v", path, err)
+	}
+	return contractAbi, nil
+}
+
+// EventTopic returns the topic which identifies the named event in Ethereum logs
+func EventTopic(contractAbi abi.ABI, eventName string) (common.Hash, error) {
+	event, ok := contractAbi.Events[eventName]
+	if !ok {
+		return common.Hash{}, fmt.Errorf("event %s not found in contract ABI", eventName)
+	}
+	return event.Id(), nil
+}
+
+// Item is an item held by the contract, as returned by its viewItem() getter
+type Item struct {
+	Sender    common.Address
+	Recipient []byte
+	Token     common.Address
+	Amount    *big.Int
+	Nonce     *big.Int
+}
+
+// IsActive calls the contract's active() getter to find whether locking is currently enabled
+func IsActive(ctx context.Context, logger log.Logger, caller ethereum.ContractCaller, contractAbi abi.ABI,
+	contractAddress common.Address) (bool, error) {
+
+	var active bool
+	if err := call(ctx, caller, contractAbi, contractAddress, &active, "active"); err != nil {
+		return false, err
+	}
+	logger.With("module", "contract").Debug("Called contract", "contract", contractAddress.Hex(), "method", "active",
+		"result", active)
+	return active, nil
+}
+
+// GetStatus calls the contract's getStatus() getter to find whether the item with the given id is still locked
+func GetStatus(ctx context.Context, logger log.Logger, caller ethereum.ContractCaller, contractAbi abi.ABI,
+	contractAddress common.Address, id [32]byte) (bool, error) {
+
+	var locked bool
+	if err := call(ctx, caller, contractAbi, contractAddress, &locked, "getStatus", id); err != nil {
+		return false, err
+	}
+	logger.With("module", "contract").Debug("Called contract", "contract", contractAddress.Hex(), "method", "getStatus",
+		"id", common.Hash(id).Hex(), "result", locked)
+	return locked, nil
+}
+
+// ViewItem calls the contract's viewItem() getter for the item with the given id. The fields of an item which
+// was never created are all zero.
+func ViewItem(ctx context.Context, logger log.Logger, caller ethereum.ContractCaller, contractAbi abi.ABI,
+	contractAddress common.Address, id [32]byte) (Item, error) {
+
+	var item Item
+	outputs := &[]interface{}{&item.Sender, &item.Recipient, &item.Token, &item.Amount, &item.Nonce}
+	if err := call(ctx, caller, contractAbi, contractAddress, outputs, "viewItem", id); err != nil {
+		return Item{}, err
+	}
+	logger.With("module", "contract").Debug("Called contract", "contract", contractAddress.Hex(), "method", "viewItem",
+		"id", common.Hash(id).Hex(), "sender", item.Sender.Hex(), "nonce", item.Nonce)
+	return item, nil
+}
+
+// call calls a getter of the contract at the latest block and unpacks its outputs into result
+func call(ctx context.Context, caller ethereum.ContractCaller, contractAbi abi.ABI, contractAddress common.Address,
+	result interface{}, method string, args ...interface{}) error {
+
+	input, err := contractAbi.Pack(method, args...)
+	if err != nil {
+		return err
 	}
 
-	// Convert the raw abi into a usable format
-	contractAbi, err := abi.JSON(strings.NewReader(string(rawContractAbi)))
+	output, err := caller.CallContract(ctx, ethereum.CallMsg{To: &contractAddress, Data: input}, nil)
 	if err != nil {
-		log.Fatal(err)
+		return err
+	}
+	if len(output) == 0 {
+		return fmt.Errorf("no contract code at address %s", contractAddress.Hex())
 	}
 
-	return contractAbi
+	err = contractAbi.Unpack(result, method, output)
+	if err != nil {
+		return fmt.Errorf("failed to unpack %s(): %v", method, err)
+	}
+	return nil
+}
+
+// EventName returns the name of the event identified by the given topic
+func EventName(contractAbi abi.ABI, topic common.Hash) (string, bool) {
+	for name, event := range contractAbi.Events {
+		if event.Id() == topic {
+			return name, true
+		}
+	}
+	return "", false
 }
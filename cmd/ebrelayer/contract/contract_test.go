@@ -1,22 +1,109 @@
 package contract
 
 import (
-  "testing"
-  "io/ioutil"
-  "strings"
-  "log"
+	"context"
+	"math/big"
+	"testing"
 
-  "github.com/stretchr/testify/require"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
 )
 
-// Set up data for parameters and to compare against
+// fakeCaller returns a fixed output for every contract call
+type fakeCaller struct {
+	output []byte
+}
+
+func (c fakeCaller) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	return c.output, nil
+}
+
 func TestLoadABI(t *testing.T) {
+	contractAbi, err := LoadABI()
+	require.NoError(t, err)
+
+	for _, eventName := range []string{"LogLock", "LogUnlock", "LogWithdraw", "LogLockingPaused", "LogLockingActivated"} {
+		_, ok := contractAbi.Events[eventName]
+		require.True(t, ok, eventName)
+	}
+}
+
+// The embedded ABI must match the ABI file it was generated from
+func TestEmbeddedABIMatchesFile(t *testing.T) {
+	embeddedAbi, err := LoadABI()
+	require.NoError(t, err)
+
+	fileAbi, err := LoadABIFromFile("./PeggyABI.json")
+	require.NoError(t, err)
+
+	require.Equal(t, fileAbi, embeddedAbi)
+}
+
+func TestEventTopic(t *testing.T) {
+	contractAbi, err := LoadABI()
+	require.NoError(t, err)
+
+	topic, err := EventTopic(contractAbi, "LogLock")
+	require.NoError(t, err)
+	require.Equal(t, crypto.Keccak256Hash([]byte("LogLock(bytes32,address,bytes,address,uint256,uint256)")), topic)
+
+	name, ok := EventName(contractAbi, topic)
+	require.True(t, ok)
+	require.Equal(t, "LogLock", name)
+
+	_, err = EventTopic(contractAbi, "LogMissing")
+	require.Error(t, err)
+}
+
+func TestIsActive(t *testing.T) {
+	contractAbi, err := LoadABI()
+	require.NoError(t, err)
+	contractAddress := common.HexToAddress("0xC4cE93a5699c68241fc2fB503Fb0f21724A624BB")
+
+	for _, expected := range []bool{true, false} {
+		output, err := contractAbi.Methods["active"].Outputs.Pack(expected)
+		require.NoError(t, err)
+
+		active, err := IsActive(context.Background(), log.NewNopLogger(), fakeCaller{output}, contractAbi, contractAddress)
+		require.NoError(t, err)
+		require.Equal(t, expected, active)
+	}
+
+	// No code is deployed at the address
+	_, err = IsActive(context.Background(), log.NewNopLogger(), fakeCaller{}, contractAbi, contractAddress)
+	require.Error(t, err)
+}
+
+func TestGetStatusAndViewItem(t *testing.T) {
+	contractAbi, err := LoadABI()
+	require.NoError(t, err)
+	contractAddress := common.HexToAddress("0xC4cE93a5699c68241fc2fB503Fb0f21724A624BB")
+	id := crypto.Keccak256Hash([]byte("item"))
+
+	output, err := contractAbi.Methods["getStatus"].Outputs.Pack(true)
+	require.NoError(t, err)
+	locked, err := GetStatus(context.Background(), log.NewNopLogger(), fakeCaller{output}, contractAbi, contractAddress, id)
+	require.NoError(t, err)
+	require.True(t, locked)
 
-	//Get the ABI ready
-	rawContractAbi, errorMsg := ioutil.ReadFile("./PeggyABI.json")
-	if errorMsg != nil {
-		log.Fatal(errorMsg)
+	expected := Item{
+		Sender:    common.HexToAddress("0xC8Ee928625908D90d4B60859052aD200CBe2792A"),
+		Recipient: []byte("cosmos1gn8409qq9hnrxde37kuxwx5hrxpfpv8426szuv"),
+		Token:     common.Address{},
+		Amount:    big.NewInt(7),
+		Nonce:     big.NewInt(39),
 	}
+	output, err = contractAbi.Methods["viewItem"].Outputs.Pack(expected.Sender, expected.Recipient, expected.Token,
+		expected.Amount, expected.Nonce)
+	require.NoError(t, err)
+	item, err := ViewItem(context.Background(), log.NewNopLogger(), fakeCaller{output}, contractAbi, contractAddress, id)
+	require.NoError(t, err)
+	require.Equal(t, expected, item)
 
-	require.True(t, strings.Contains(string(rawContractAbi), "LogLock"))
-}
\ No newline at end of file
+	// No code is deployed at the address
+	_, err = ViewItem(context.Background(), log.NewNopLogger(), fakeCaller{}, contractAbi, contractAddress, id)
+	require.Error(t, err)
+}
@@ -0,0 +1,53 @@
+package events
+
+// -----------------------------------------------------
+//    Errors
+//
+// 		Typed errors returned when raw Ethereum logs
+//		cannot be parsed into events.
+// -----------------------------------------------------
+
+import (
+	"fmt"
+)
+
+// ErrorCode classifies why an event could not be parsed
+type ErrorCode int
+
+const (
+	CodeUnsupportedEvent ErrorCode = 1
+	CodeUnpackFailed     ErrorCode = 2
+	CodeMissingField     ErrorCode = 3
+)
+
+// EventError is returned when raw event data cannot be parsed
+type EventError struct {
+	Code      ErrorCode
+	EventName string
+	Err       error
+}
+
+func (e EventError) Error() string {
+	switch e.Code {
+	case CodeUnsupportedEvent:
+		return fmt.Sprintf("unsupported event: %s", e.EventName)
+	case CodeUnpackFailed:
+		return fmt.Sprintf("failed to unpack %s event: %v", e.EventName, e.Err)
+	case CodeMissingField:
+		return fmt.Sprintf("%s event is missing required field: %v", e.EventName, e.Err)
+	default:
+		return fmt.Sprintf("invalid %s event: %v", e.EventName, e.Err)
+	}
+}
+
+func ErrUnsupportedEvent(eventName string) error {
+	return EventError{Code: CodeUnsupportedEvent, EventName: eventName}
+}
+
+func ErrUnpackFailed(eventName string, err error) error {
+	return EventError{Code: CodeUnpackFailed, EventName: eventName, Err: err}
+}
+
+func ErrMissingField(eventName string, field string) error {
+	return EventError{Code: CodeMissingField, EventName: eventName, Err: fmt.Errorf("%s", field)}
+}
@@ -3,48 +3,166 @@ package events
 // -----------------------------------------------------
 //    Event
 //
-// 		Creates LockEvents from new events on the ethereum
+// 		Creates typed events from new events on the
 //		Ethereum blockchain.
 // -----------------------------------------------------
 
 import (
 	"encoding/hex"
 	"fmt"
-	"log"
 	"math/big"
 
-	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/swishlabsco/cosmos-ethereum-bridge/cmd/ebrelayer/contract"
+)
 
+// Names of the events emitted by the Peggy contract
+const (
+	LogLock             = "LogLock"
+	LogUnlock           = "LogUnlock"
+	LogWithdraw         = "LogWithdraw"
+	LogLockingPaused    = "LogLockingPaused"
+	LogLockingActivated = "LogLockingActivated"
 )
 
+// PeggyEvent is implemented by each typed Peggy contract event
+type PeggyEvent interface {
+	EventName() string
+}
+
 // LockEvent represents a single smart contract event
 type LockEvent struct {
-	Id      [32]byte
-	From    common.Address
-	To      []byte
-	Token   common.Address
-	Value   *big.Int
-	Nonce   *big.Int
+	Id    [32]byte
+	From  common.Address
+	To    []byte
+	Token common.Address
+	Value *big.Int
+	Nonce *big.Int
+}
+
+func (event LockEvent) EventName() string { return LogLock }
+
+// UnlockEvent is emitted when the relayer returns a locked item to its sender
+type UnlockEvent struct {
+	Id    [32]byte
+	To    common.Address
+	Token common.Address
+	Value *big.Int
+	Nonce *big.Int
+}
+
+func (event UnlockEvent) EventName() string { return LogUnlock }
+
+// WithdrawEvent is emitted when a sender withdraws their own locked item
+type WithdrawEvent struct {
+	Id    [32]byte
+	To    common.Address
+	Token common.Address
+	Value *big.Int
+	Nonce *big.Int
+}
+
+func (event WithdrawEvent) EventName() string { return LogWithdraw }
+
+// LockingPausedEvent is emitted when the relayer pauses locking on the contract
+type LockingPausedEvent struct {
+	Time *big.Int
+}
+
+func (event LockingPausedEvent) EventName() string { return LogLockingPaused }
+
+// LockingActivatedEvent is emitted when locking on the contract is activated
+type LockingActivatedEvent struct {
+	Time *big.Int
+}
+
+func (event LockingActivatedEvent) EventName() string { return LogLockingActivated }
+
+// DecodeLog identifies a raw log by its signature topic and unpacks it into the matching typed event
+func DecodeLog(contractAbi abi.ABI, vLog types.Log) (PeggyEvent, error) {
+	if len(vLog.Topics) == 0 {
+		return nil, ErrUnsupportedEvent("anonymous")
+	}
+
+	eventName, ok := contract.EventName(contractAbi, vLog.Topics[0])
+	if !ok {
+		return nil, ErrUnsupportedEvent(vLog.Topics[0].Hex())
+	}
+
+	switch eventName {
+	case LogLock:
+		event, err := NewLockEvent(contractAbi, eventName, vLog.Data)
+		if err != nil {
+			return nil, err
+		}
+		return event, nil
+	case LogUnlock:
+		event := UnlockEvent{}
+		if err := unpack(contractAbi, &event, eventName, vLog.Data); err != nil {
+			return nil, err
+		}
+		if event.Nonce == nil || event.Value == nil {
+			return nil, ErrMissingField(eventName, "_nonce/_value")
+		}
+		return event, nil
+	case LogWithdraw:
+		event := WithdrawEvent{}
+		if err := unpack(contractAbi, &event, eventName, vLog.Data); err != nil {
+			return nil, err
+		}
+		if event.Nonce == nil || event.Value == nil {
+			return nil, ErrMissingField(eventName, "_nonce/_value")
+		}
+		return event, nil
+	case LogLockingPaused:
+		event := LockingPausedEvent{}
+		if err := unpack(contractAbi, &event, eventName, vLog.Data); err != nil {
+			return nil, err
+		}
+		return event, nil
+	case LogLockingActivated:
+		event := LockingActivatedEvent{}
+		if err := unpack(contractAbi, &event, eventName, vLog.Data); err != nil {
+			return nil, err
+		}
+		return event, nil
+	default:
+		return nil, ErrUnsupportedEvent(eventName)
+	}
 }
 
-func NewLockEvent(contractAbi abi.ABI, eventName string, eventData []byte) LockEvent {
+func unpack(contractAbi abi.ABI, event interface{}, eventName string, eventData []byte) error {
+	err := contractAbi.Unpack(event, eventName, eventData)
+	if err != nil {
+		return ErrUnpackFailed(eventName, err)
+	}
+	return nil
+}
 
-	// Load Peggy smart contract abi
-	if eventName != "LogLock" {
-		log.Fatal("Only LogLock events are currently supported.")
+// NewLockEvent unpacks raw event data into a LockEvent, returning an EventError on failure
+func NewLockEvent(contractAbi abi.ABI, eventName string, eventData []byte) (LockEvent, error) {
+	if eventName != LogLock {
+		return LockEvent{}, ErrUnsupportedEvent(eventName)
 	}
 
 	// Parse the event's attributes as Ethereum network variables
 	event := LockEvent{}
-	err := contractAbi.Unpack(&event, eventName, eventData)
+	err := unpack(contractAbi, &event, eventName, eventData)
 	if err != nil {
-	    log.Fatal("Unpacking: ", err)
+		return LockEvent{}, err
 	}
 
-	PrintEvent(event)
+	if event.Nonce == nil {
+		return LockEvent{}, ErrMissingField(eventName, "_nonce")
+	}
+	if event.Value == nil {
+		return LockEvent{}, ErrMissingField(eventName, "_value")
+	}
 
-	return event
+	return event, nil
 }
 
 func PrintEvent(event LockEvent) {
@@ -58,5 +176,5 @@ func PrintEvent(event LockEvent) {
 
 	// Print the event's information
 	fmt.Printf("\nEvent ID: %v\nToken: %v\nSender: %v\nRecipient: %v\nValue: %v\nNonce: %v\n\n",
-							id, token, sender, recipient, value, nonce)
-}
\ No newline at end of file
+		id, token, sender, recipient, value, nonce)
+}
@@ -0,0 +1,126 @@
+package events
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/swishlabsco/cosmos-ethereum-bridge/cmd/ebrelayer/contract"
+)
+
+const (
+	TestTxHash = "0xab85e2ceaa7d100af2f07cac01365f3777153a4e004342dca5db44e731b9d461"
+)
+
+// createTestLog packs the event's arguments into a log as the Peggy contract would emit it
+func createTestLog(t *testing.T, contractABI abi.ABI, eventName string, args ...interface{}) types.Log {
+	event := contractABI.Events[eventName]
+	data, err := event.Inputs.Pack(args...)
+	require.NoError(t, err)
+
+	return types.Log{
+		Topics: []common.Hash{event.Id()},
+		Data:   data,
+		TxHash: common.HexToHash(TestTxHash),
+	}
+}
+
+func TestNewLockEventUnsupported(t *testing.T) {
+	_, err := NewLockEvent(abi.ABI{}, LogUnlock, []byte{})
+	require.Error(t, err)
+
+	eventErr, ok := err.(EventError)
+	require.True(t, ok)
+	require.Equal(t, CodeUnsupportedEvent, eventErr.Code)
+}
+
+func TestNewLockEventMalformed(t *testing.T) {
+	contractABI, err := contract.LoadABI()
+	require.NoError(t, err)
+
+	_, err = NewLockEvent(contractABI, LogLock, []byte{0x01, 0x02})
+	require.Error(t, err)
+
+	eventErr, ok := err.(EventError)
+	require.True(t, ok)
+	require.Equal(t, CodeUnpackFailed, eventErr.Code)
+}
+
+func TestDecodeLog(t *testing.T) {
+	contractABI, err := contract.LoadABI()
+	require.NoError(t, err)
+
+	var id [32]byte
+	copy(id[:], common.HexToHash(TestTxHash).Bytes())
+	sender := common.HexToAddress("0xC8Ee928625908D90d4B60859052aD200CBe2792A")
+
+	// LogLock
+	vLog := createTestLog(t, contractABI, LogLock, id, sender, []byte("cosmos1gn8409qq9hnrxde37kuxwx5hrxpfpv8426szuv"),
+		common.Address{}, big.NewInt(7), big.NewInt(39))
+	event, err := DecodeLog(contractABI, vLog)
+	require.NoError(t, err)
+	lockEvent, ok := event.(LockEvent)
+	require.True(t, ok)
+	require.Equal(t, sender, lockEvent.From)
+	require.Equal(t, big.NewInt(39), lockEvent.Nonce)
+
+	// LogWithdraw
+	vLog = createTestLog(t, contractABI, LogWithdraw, id, sender, common.Address{}, big.NewInt(7), big.NewInt(39))
+	event, err = DecodeLog(contractABI, vLog)
+	require.NoError(t, err)
+	withdrawEvent, ok := event.(WithdrawEvent)
+	require.True(t, ok)
+	require.Equal(t, id, withdrawEvent.Id)
+	require.Equal(t, big.NewInt(7), withdrawEvent.Value)
+
+	// LogUnlock
+	vLog = createTestLog(t, contractABI, LogUnlock, id, sender, common.Address{}, big.NewInt(7), big.NewInt(39))
+	event, err = DecodeLog(contractABI, vLog)
+	require.NoError(t, err)
+	require.Equal(t, LogUnlock, event.EventName())
+
+	// LogLockingPaused
+	vLog = createTestLog(t, contractABI, LogLockingPaused, big.NewInt(1560000000))
+	event, err = DecodeLog(contractABI, vLog)
+	require.NoError(t, err)
+	pausedEvent, ok := event.(LockingPausedEvent)
+	require.True(t, ok)
+	require.Equal(t, big.NewInt(1560000000), pausedEvent.Time)
+
+	// LogLockingActivated
+	vLog = createTestLog(t, contractABI, LogLockingActivated, big.NewInt(1560000000))
+	event, err = DecodeLog(contractABI, vLog)
+	require.NoError(t, err)
+	require.Equal(t, LogLockingActivated, event.EventName())
+
+	// Unknown topic
+	vLog.Topics = []common.Hash{common.HexToHash("0x01")}
+	_, err = DecodeLog(contractABI, vLog)
+	require.Error(t, err)
+	require.Equal(t, CodeUnsupportedEvent, err.(EventError).Code)
+}
+
+func TestNewDeadLetterWrite(t *testing.T) {
+	require.False(t, IsDeadLetterRecorded(TestTxHash, 0))
+
+	NewDeadLetterWrite(TestTxHash, 0, 10, LogLock, []byte{0x01}, errors.New("bad event"))
+
+	require.True(t, IsDeadLetterRecorded(TestTxHash, 0))
+	require.False(t, IsDeadLetterRecorded(TestTxHash, 1))
+	deadLetter := DeadLetterRecords[DeadLetterKey(TestTxHash, 0)]
+	require.Equal(t, "bad event", deadLetter.Err)
+	require.Equal(t, uint64(10), deadLetter.BlockNumber)
+
+	//Another failed log of the same transaction is kept alongside the first
+	NewDeadLetterWrite(TestTxHash, 1, 10, LogLock, []byte{0x02}, errors.New("another bad event"))
+
+	require.True(t, IsDeadLetterRecorded(TestTxHash, 1))
+	require.Equal(t, "bad event", DeadLetterRecords[DeadLetterKey(TestTxHash, 0)].Err)
+	require.Equal(t, "another bad event", DeadLetterRecords[DeadLetterKey(TestTxHash, 1)].Err)
+	require.Equal(t, uint(1), DeadLetterRecords[DeadLetterKey(TestTxHash, 1)].LogIndex)
+}
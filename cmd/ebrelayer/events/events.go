@@ -9,13 +9,55 @@ package events
 
 import (
 	"fmt"
+	"sync"
 
+	"github.com/tendermint/tendermint/libs/log"
 )
 
+// logger reports records which need an operator's attention, such as dead letters
+var logger = log.NewNopLogger()
+
+// SetLogger sets the logger records are reported to
+func SetLogger(l log.Logger) {
+	logger = l
+}
+
+// recordsMtx guards the records below, which are written by the relayer loop and its relay workers
+var recordsMtx sync.RWMutex
+
 var EventRecords = make(map[string]LockEvent)
 
+// DeadLetterRecords holds raw events which could not be parsed or relayed, keyed by transaction hash and log index
+var DeadLetterRecords = make(map[string]DeadLetter)
+
+// RelayOutcomeRecords holds the final outcome of each relayed claim, keyed by the claim's prophecy id
+var RelayOutcomeRecords = make(map[string]RelayOutcome)
+
+// RelayOutcome is the final state of a claim after it was relayed and confirmed or given up on
+type RelayOutcome struct {
+	Status    string
+	TxHash    string
+	Height    int64
+	Code      uint32
+	Codespace string
+	Attempts  int
+	Err       string
+}
+
+// DeadLetter is the raw data of an event which failed processing, kept for later inspection
+type DeadLetter struct {
+	TxHash      string
+	LogIndex    uint
+	BlockNumber uint64
+	EventName   string
+	Data        []byte
+	Err         string
+}
+
 // Add a validator's address to the official claims list
 func NewEventWrite(txHash string, event LockEvent) bool {
+	recordsMtx.Lock()
+	defer recordsMtx.Unlock()
 	EventRecords[txHash] = event
 
 	return true
@@ -23,14 +65,66 @@ func NewEventWrite(txHash string, event LockEvent) bool {
 
 // Checks the sessions stored events for this transaction hash
 func IsEventRecorded(txHash string) bool {
-	if EventRecords[txHash].Nonce == nil  {
+	recordsMtx.RLock()
+	defer recordsMtx.RUnlock()
+	if EventRecords[txHash].Nonce == nil {
 		return false
 	}
 	return true
 }
 
+// DeadLetterKey returns the key of the dead letter of a log. A transaction can emit several logs, so the log index
+// is part of the key.
+func DeadLetterKey(txHash string, logIndex uint) string {
+	return fmt.Sprintf("%s:%d", txHash, logIndex)
+}
+
+// NewDeadLetterWrite records an event which failed processing, along with the reason for the failure
+func NewDeadLetterWrite(txHash string, logIndex uint, blockNumber uint64, eventName string, data []byte, err error) bool {
+	recordsMtx.Lock()
+	defer recordsMtx.Unlock()
+	DeadLetterRecords[DeadLetterKey(txHash, logIndex)] = DeadLetter{
+		TxHash:      txHash,
+		LogIndex:    logIndex,
+		BlockNumber: blockNumber,
+		EventName:   eventName,
+		Data:        data,
+		Err:         err.Error(),
+	}
+	logger.Error("Recorded dead letter", "tx_hash", txHash, "log_index", logIndex, "block", blockNumber, "event", eventName, "err", err)
+
+	return true
+}
+
+// Checks the sessions dead letters for this log
+func IsDeadLetterRecorded(txHash string, logIndex uint) bool {
+	recordsMtx.RLock()
+	defer recordsMtx.RUnlock()
+	_, ok := DeadLetterRecords[DeadLetterKey(txHash, logIndex)]
+	return ok
+}
+
+// NewRelayOutcomeWrite records the final outcome of relaying a claim
+func NewRelayOutcomeWrite(prophecyID string, outcome RelayOutcome) bool {
+	recordsMtx.Lock()
+	defer recordsMtx.Unlock()
+	RelayOutcomeRecords[prophecyID] = outcome
+
+	return true
+}
+
+// GetRelayOutcome returns the recorded outcome of relaying a claim, if any
+func GetRelayOutcome(prophecyID string) (RelayOutcome, bool) {
+	recordsMtx.RLock()
+	defer recordsMtx.RUnlock()
+	outcome, ok := RelayOutcomeRecords[prophecyID]
+	return outcome, ok
+}
+
 func PrintEventByTx(txHash string) {
 	if IsEventRecorded(txHash) {
+		recordsMtx.RLock()
+		defer recordsMtx.RUnlock()
 		PrintEvent(EventRecords[txHash])
 	} else {
 		fmt.Printf("\nNo records from this sesson for tx: %v\n", txHash)
@@ -39,12 +133,24 @@ func PrintEventByTx(txHash string) {
 
 // Prints all the claims made on this event
 func PrintEvents() error {
+	recordsMtx.RLock()
+	defer recordsMtx.RUnlock()
 
- 	// For each claim, print the validator which submitted the claim
-  for tx, event := range EventRecords {
-    fmt.Printf("\nTransaction: %v\n", tx)
-    PrintEvent(event)
-  }
+	// For each claim, print the validator which submitted the claim
+	for tx, event := range EventRecords {
+		fmt.Printf("\nTransaction: %v\n", tx)
+		PrintEvent(event)
+	}
 
-  return nil
+	return nil
+}
+
+// Prints all events which could not be processed this session
+func PrintDeadLetters() {
+	recordsMtx.RLock()
+	defer recordsMtx.RUnlock()
+	for _, deadLetter := range DeadLetterRecords {
+		fmt.Printf("\nTransaction: %v\nLog index: %v\nBlock number: %v\nEvent: %v\nError: %v\n",
+			deadLetter.TxHash, deadLetter.LogIndex, deadLetter.BlockNumber, deadLetter.EventName, deadLetter.Err)
+	}
 }
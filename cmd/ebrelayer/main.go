@@ -8,30 +8,38 @@ package main
 // -------------------------------------------------------------
 
 import (
-	"encoding/hex"
+	stdcontext "context"
 	"fmt"
 	"os"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/client/rpc"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	amino "github.com/tendermint/go-amino"
 	"github.com/tendermint/tendermint/libs/cli"
 
-	// "golang.org/x/crypto"
-
 	app "github.com/swishlabsco/cosmos-ethereum-bridge"
+	"github.com/swishlabsco/cosmos-ethereum-bridge/cmd/ebrelayer/config"
+	"github.com/swishlabsco/cosmos-ethereum-bridge/cmd/ebrelayer/contract"
 	relayer "github.com/swishlabsco/cosmos-ethereum-bridge/cmd/ebrelayer/relayer"
+	"github.com/swishlabsco/cosmos-ethereum-bridge/cmd/ebrelayer/txs"
 )
 
 const (
 	storeAcc       = "acc"
 	routeEthbridge = "ethbridge"
+
+	flagConfig    = "config"
+	flagABI       = "abi"
+	flagOverwrite = "overwrite"
 )
 
-var defaultCLIHome = os.ExpandEnv("$HOME/.ebcli")
+var defaultCLIHome = config.DefaultCLIHome
 var appCodec *amino.Codec
 
 func init() {
@@ -50,6 +58,8 @@ func init() {
 	rootCmd.AddCommand(
 		rpc.StatusCommand(),
 		initRelayerCmd(),
+		configCmd(),
+		supplyCmd(),
 	)
 
 	executor := cli.PrepareMainCmd(rootCmd, "EBRELAYER", defaultCLIHome)
@@ -67,65 +77,178 @@ var rootCmd = &cobra.Command{
 
 func initRelayerCmd() *cobra.Command {
 	initRelayerCmd := &cobra.Command{
-		Use:   "init chain-id web3-provider contract-address event-signature validatorFromName",
+		Use:   "init",
 		Short: "Initalizes a web socket which streams live events from a smart contract",
+		Args:  cobra.NoArgs,
 		RunE:  RunRelayerCmd,
 	}
+	initRelayerCmd.Flags().String(flagConfig, config.DefaultConfigFile, "path to the relayer's config file")
+	initRelayerCmd.Flags().String(flagABI, "", "path to an alternate contract ABI, defaults to the Peggy ABI built into the relayer")
 
 	return initRelayerCmd
 }
 
+func configCmd() *cobra.Command {
+	configCmd := &cobra.Command{
+		Use:   "config",
+		Short: "Create and validate the relayer's config file",
+	}
+
+	configInitCmd := &cobra.Command{
+		Use:   "init",
+		Short: "Writes a default config file to be completed with the relayer's settings",
+		Args:  cobra.NoArgs,
+		RunE:  RunConfigInitCmd,
+	}
+	configInitCmd.Flags().String(flagConfig, config.DefaultConfigFile, "path to the relayer's config file")
+	configInitCmd.Flags().BoolP(flagOverwrite, "o", false, "overwrite an existing config file")
+
+	configValidateCmd := &cobra.Command{
+		Use:   "validate",
+		Short: "Checks that the config file can be loaded and is valid",
+		Args:  cobra.NoArgs,
+		RunE:  RunConfigValidateCmd,
+	}
+	configValidateCmd.Flags().String(flagConfig, config.DefaultConfigFile, "path to the relayer's config file")
+
+	configCmd.AddCommand(configInitCmd, configValidateCmd)
+	return configCmd
+}
+
+func supplyCmd() *cobra.Command {
+	supplyCmd := &cobra.Command{
+		Use:   "supply",
+		Short: "Compares the coins minted by the bridge with the ether locked in the configured bridge contracts",
+		Args:  cobra.NoArgs,
+		RunE:  RunSupplyCmd,
+	}
+	supplyCmd.Flags().String(flagConfig, config.DefaultConfigFile, "path to the relayer's config file")
+
+	return supplyCmd
+}
+
 func RunRelayerCmd(cmd *cobra.Command, args []string) error {
-	if len(args) != 5 {
-		return fmt.Errorf("Expected 5 arguments, got %v", len(args))
+	configFile, err := cmd.Flags().GetString(flagConfig)
+	if err != nil {
+		return err
 	}
 
-	// Parse chain's ID
-	chainId := args[0]
-	if chainId == "" {
-		return fmt.Errorf("Invalid chain-id: %v", chainId)
+	cfg, err := config.LoadConfig(configFile)
+	if err != nil {
+		return err
 	}
 
-	// Parse ethereum provider
-	ethereumProvider := args[1]
-	if !relayer.IsWebsocketURL(ethereumProvider) {
-		return fmt.Errorf("Invalid web3-provider: %v", ethereumProvider)
+	logger, err := relayer.NewLogger(cfg.Log, os.Stdout)
+	if err != nil {
+		return err
 	}
 
-	// Parse the address of the deployed contract
-	bytesContractAddress, err := hex.DecodeString(args[2])
+	// Load the contract's ABI, either built in or from the given file
+	abiFile, err := cmd.Flags().GetString(flagABI)
 	if err != nil {
-		return fmt.Errorf("Invalid contract-address: %v", bytesContractAddress)
+		return err
 	}
-	contractAddress := common.BytesToAddress(bytesContractAddress)
 
-	// Parse the event signature for the subscription
-	eventSig := "0xe154a56f2d306d5bbe4ac2379cb0cfc906b23685047a2bd2f5f0a0e810888f72"
-	// eventSig := crypto.Keccak256Hash([]byte(args[3]))
-	if eventSig == "" {
-		return fmt.Errorf("Invalid event-signature: %v", eventSig)
+	var contractABI abi.ABI
+	if abiFile == "" {
+		contractABI, err = contract.LoadABI()
+	} else {
+		contractABI, err = contract.LoadABIFromFile(abiFile)
+	}
+	if err != nil {
+		return err
 	}
 
-	// Parse the validator running the relayer service
-	validatorFrom := args[4]
+	// The cosmos client context and keybase read their settings through viper
+	viper.Set(client.FlagNode, cfg.Cosmos.Node)
+	viper.Set(client.FlagChainID, cfg.Cosmos.ChainID)
+	viper.Set(client.FlagTrustNode, cfg.Cosmos.TrustNode)
+	viper.Set(cli.HomeFlag, cfg.Cosmos.Home)
 
 	// Initialize the relayer
-	initErr := relayer.InitRelayer(
-		appCodec,
-		chainId,
-		ethereumProvider,
-		contractAddress,
-		eventSig,
-		validatorFrom)
-
+	initErr := relayer.InitRelayer(logger, appCodec, cfg, contractABI)
 	if initErr != nil {
-		fmt.Printf("%v", initErr)
+		logger.Error("Relayer stopped", "err", initErr)
 		return initErr
 	}
 
 	return nil
 }
 
+// RunSupplyCmd reports the drift between the bridged supply on the Cosmos node and the ether held by the bridge
+// contract of each configured network
+func RunSupplyCmd(cmd *cobra.Command, args []string) error {
+	configFile, err := cmd.Flags().GetString(flagConfig)
+	if err != nil {
+		return err
+	}
+
+	cfg, err := config.LoadConfig(configFile)
+	if err != nil {
+		return err
+	}
+
+	logger, err := relayer.NewLogger(cfg.Log, os.Stderr)
+	if err != nil {
+		return err
+	}
+
+	viper.Set(client.FlagNode, cfg.Cosmos.Node)
+	viper.Set(client.FlagChainID, cfg.Cosmos.ChainID)
+	viper.Set(client.FlagTrustNode, cfg.Cosmos.TrustNode)
+	bridgedSupply, err := txs.QueryBridgedSupply(context.NewCLIContext().WithCodec(appCodec))
+	if err != nil {
+		return fmt.Errorf("failed to query bridged supply: %v", err)
+	}
+
+	locked, err := relayer.ReadLockedBalances(stdcontext.Background(), logger, cfg.Ethereum)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(relayer.NewSupplyReport(bridgedSupply, locked))
+	return nil
+}
+
+func RunConfigInitCmd(cmd *cobra.Command, args []string) error {
+	configFile, err := cmd.Flags().GetString(flagConfig)
+	if err != nil {
+		return err
+	}
+
+	overwrite, err := cmd.Flags().GetBool(flagOverwrite)
+	if err != nil {
+		return err
+	}
+
+	if _, err := os.Stat(configFile); err == nil && !overwrite {
+		return fmt.Errorf("config file already exists: %v", configFile)
+	}
+
+	err = config.WriteConfigFile(configFile, config.DefaultConfig())
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Wrote default relayer config to %s\n", configFile)
+	return nil
+}
+
+func RunConfigValidateCmd(cmd *cobra.Command, args []string) error {
+	configFile, err := cmd.Flags().GetString(flagConfig)
+	if err != nil {
+		return err
+	}
+
+	_, err = config.LoadConfig(configFile)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Config file %s is valid\n", configFile)
+	return nil
+}
+
 func main() {
 	err := rootCmd.Execute()
 	if err != nil {
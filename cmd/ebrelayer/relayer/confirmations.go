@@ -0,0 +1,75 @@
+package relayer
+
+// ------------------------------------------------------------
+//    Confirmations
+//
+//    Holds Ethereum logs until they are buried under the
+//    configured number of blocks, so that events from
+//    blocks which are later reorganized away aren't relayed.
+// ------------------------------------------------------------
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// PendingLogs is a set of logs waiting for enough confirmations to be relayed
+type PendingLogs struct {
+	confirmations uint64
+	pending       map[string]types.Log
+	released      map[string]bool
+}
+
+// NewPendingLogs returns an empty set of logs which are released after the given number of confirmations
+func NewPendingLogs(confirmations uint64) *PendingLogs {
+	return &PendingLogs{
+		confirmations: confirmations,
+		pending:       make(map[string]types.Log),
+		released:      make(map[string]bool),
+	}
+}
+
+func logKey(vLog types.Log) string {
+	return fmt.Sprintf("%s:%d", vLog.TxHash.Hex(), vLog.Index)
+}
+
+// Add holds a log until it is confirmed. Logs which are already pending or released are ignored,
+// and logs marked as removed by a chain reorganization are dropped.
+func (p *PendingLogs) Add(vLog types.Log) {
+	key := logKey(vLog)
+	if vLog.Removed {
+		delete(p.pending, key)
+		return
+	}
+	if p.released[key] {
+		return
+	}
+	p.pending[key] = vLog
+}
+
+// Confirmed removes and returns the logs with enough confirmations at the given head block, in chain order
+func (p *PendingLogs) Confirmed(head uint64) []types.Log {
+	var confirmed []types.Log
+	for key, vLog := range p.pending {
+		if vLog.BlockNumber+p.confirmations <= head {
+			confirmed = append(confirmed, vLog)
+			p.released[key] = true
+			delete(p.pending, key)
+		}
+	}
+
+	sort.Slice(confirmed, func(i, j int) bool {
+		if confirmed[i].BlockNumber != confirmed[j].BlockNumber {
+			return confirmed[i].BlockNumber < confirmed[j].BlockNumber
+		}
+		return confirmed[i].Index < confirmed[j].Index
+	})
+	return confirmed
+}
+
+// Len returns the number of logs waiting for confirmations
+func (p *PendingLogs) Len() int {
+	return len(p.pending)
+}
@@ -0,0 +1,41 @@
+package relayer
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPendingLogs(t *testing.T) {
+	pending := NewPendingLogs(3)
+
+	log1 := types.Log{TxHash: common.HexToHash("0x01"), BlockNumber: 10, Index: 1}
+	log2 := types.Log{TxHash: common.HexToHash("0x02"), BlockNumber: 10, Index: 0}
+	log3 := types.Log{TxHash: common.HexToHash("0x03"), BlockNumber: 12, Index: 0}
+
+	pending.Add(log1)
+	pending.Add(log2)
+	pending.Add(log3)
+	require.Equal(t, 3, pending.Len())
+
+	// Not enough confirmations yet
+	require.Empty(t, pending.Confirmed(12))
+
+	// Logs from block 10 are released in chain order
+	confirmed := pending.Confirmed(13)
+	require.Equal(t, []types.Log{log2, log1}, confirmed)
+	require.Equal(t, 1, pending.Len())
+
+	// Released logs are not held again when seen twice
+	pending.Add(log1)
+	require.Equal(t, 1, pending.Len())
+
+	// Logs removed by a reorganization are dropped
+	removed := log3
+	removed.Removed = true
+	pending.Add(removed)
+	require.Equal(t, 0, pending.Len())
+	require.Empty(t, pending.Confirmed(100))
+}
@@ -0,0 +1,156 @@
+package relayer
+
+// ------------------------------------------------------------
+//    Health
+//
+//    Tracks the relayer's connection to each Ethereum
+//    network and to the Cosmos node, and serves them along
+//    with the relayer's metrics over HTTP.
+// ------------------------------------------------------------
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// EthereumHealth is the state of a watcher's connection to its network
+type EthereumHealth struct {
+	Connected   bool      `json:"connected"`
+	Provider    string    `json:"provider,omitempty"`
+	LatestBlock uint64    `json:"latest_block"`
+	LastHeader  time.Time `json:"last_header"`
+	Error       string    `json:"error,omitempty"`
+}
+
+// CosmosHealth is the state of the connection to the Cosmos node
+type CosmosHealth struct {
+	Connected bool   `json:"connected"`
+	Error     string `json:"error,omitempty"`
+}
+
+// HealthReport is served on /health. The relayer is healthy when every watcher and the Cosmos node are connected.
+type HealthReport struct {
+	Healthy  bool                      `json:"healthy"`
+	Ethereum map[string]EthereumHealth `json:"ethereum"`
+	Cosmos   CosmosHealth              `json:"cosmos"`
+}
+
+// Health tracks the state of the relayer's chain connections
+type Health struct {
+	mtx         sync.RWMutex
+	networks    map[string]EthereumHealth
+	checkCosmos func() error
+}
+
+// NewHealth returns a health tracker which checks the Cosmos node with the given function on each report
+func NewHealth(checkCosmos func() error) *Health {
+	return &Health{
+		networks:    make(map[string]EthereumHealth),
+		checkCosmos: checkCosmos,
+	}
+}
+
+// AddNetwork registers a watcher's network, which is disconnected until the watcher subscribes to it
+func (h *Health) AddNetwork(network string) {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+	h.networks[network] = EthereumHealth{}
+}
+
+// SetConnected records that a watcher is subscribed to its network through the given provider
+func (h *Health) SetConnected(network string, provider string) {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+	state := h.networks[network]
+	state.Connected = true
+	state.Provider = provider
+	state.Error = ""
+	h.networks[network] = state
+}
+
+// SetDisconnected records that a watcher lost its connection to its network
+func (h *Health) SetDisconnected(network string, err error) {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+	state := h.networks[network]
+	state.Connected = false
+	if err != nil {
+		state.Error = err.Error()
+	}
+	h.networks[network] = state
+}
+
+// SetLatestBlock records the latest block header received from a network
+func (h *Health) SetLatestBlock(network string, block uint64) {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+	state := h.networks[network]
+	state.LatestBlock = block
+	state.LastHeader = time.Now()
+	h.networks[network] = state
+}
+
+// Report checks the Cosmos node and returns the state of every connection
+func (h *Health) Report() HealthReport {
+	report := HealthReport{
+		Healthy:  true,
+		Ethereum: make(map[string]EthereumHealth),
+	}
+
+	h.mtx.RLock()
+	for network, state := range h.networks {
+		report.Ethereum[network] = state
+		report.Healthy = report.Healthy && state.Connected
+	}
+	h.mtx.RUnlock()
+
+	report.Cosmos.Connected = true
+	if err := h.checkCosmos(); err != nil {
+		report.Cosmos = CosmosHealth{Connected: false, Error: err.Error()}
+		report.Healthy = false
+	}
+	return report
+}
+
+// NewMetricsHandler serves the relayer's metrics in the Prometheus format on /metrics and its health report on
+// /health, with a 503 status when any connection is down
+func NewMetricsHandler(metrics *Metrics, health *Health) http.Handler {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(metrics.Registry(), promhttp.HandlerOpts{}))
+	mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
+		report := health.Report()
+
+		w.Header().Set("Content-Type", "application/json")
+		if !report.Healthy {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+		_ = json.NewEncoder(w).Encode(report)
+	})
+	return mux
+}
+
+// ServeMetrics serves the handler on the listener until the context is cancelled
+func ServeMetrics(ctx context.Context, listener net.Listener, handler http.Handler) error {
+	server := &http.Server{
+		Handler:      handler,
+		ReadTimeout:  10 * time.Second,
+		WriteTimeout: 30 * time.Second,
+	}
+
+	go func() {
+		<-ctx.Done()
+		server.Close()
+	}()
+
+	err := server.Serve(listener)
+	if err == http.ErrServerClosed {
+		return nil
+	}
+	return err
+}
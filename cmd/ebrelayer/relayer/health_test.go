@@ -0,0 +1,94 @@
+package relayer
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHealthReport(t *testing.T) {
+	var cosmosErr error
+	health := NewHealth(func() error { return cosmosErr })
+	health.AddNetwork("ropsten")
+	health.AddNetwork("rinkeby")
+
+	// Watchers are unhealthy until they have subscribed
+	require.False(t, health.Report().Healthy)
+
+	health.SetConnected("ropsten", Socket)
+	health.SetConnected("rinkeby", "wss://rinkeby.infura.io/ws")
+	health.SetLatestBlock("ropsten", 42)
+	report := health.Report()
+	require.True(t, report.Healthy)
+	require.True(t, report.Cosmos.Connected)
+	require.Equal(t, uint64(42), report.Ethereum["ropsten"].LatestBlock)
+	require.Equal(t, Socket, report.Ethereum["ropsten"].Provider)
+
+	health.SetDisconnected("rinkeby", errors.New("websocket: close 1006"))
+	report = health.Report()
+	require.False(t, report.Healthy)
+	require.False(t, report.Ethereum["rinkeby"].Connected)
+	require.Equal(t, "websocket: close 1006", report.Ethereum["rinkeby"].Error)
+	require.True(t, report.Ethereum["ropsten"].Connected)
+
+	health.SetConnected("rinkeby", "wss://rinkeby.infura.io/ws")
+	cosmosErr = errors.New("connection refused")
+	report = health.Report()
+	require.False(t, report.Healthy)
+	require.False(t, report.Cosmos.Connected)
+	require.Equal(t, "connection refused", report.Cosmos.Error)
+}
+
+func TestMetricsHandler(t *testing.T) {
+	var cosmosErr error
+	metrics := NewMetrics()
+	health := NewHealth(func() error { return cosmosErr })
+	health.AddNetwork("ropsten")
+	health.SetConnected("ropsten", Socket)
+
+	metrics.IncEventsSeen("ropsten")
+	metrics.IncEventsParsed("ropsten")
+	metrics.SetLatestBlock("ropsten", 42)
+	metrics.IncEventsRelayed()
+	metrics.ObserveBroadcastLatency(120 * time.Millisecond)
+	metrics.SetAccountSequence(7)
+
+	server := httptest.NewServer(NewMetricsHandler(metrics, health))
+	defer server.Close()
+
+	res, err := http.Get(server.URL + "/metrics")
+	require.NoError(t, err)
+	body, err := ioutil.ReadAll(res.Body)
+	res.Body.Close()
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	require.Contains(t, string(body), `ebrelayer_ethereum_events_seen_total{network="ropsten"} 1`)
+	require.Contains(t, string(body), `ebrelayer_ethereum_latest_block{network="ropsten"} 42`)
+	require.Contains(t, string(body), "ebrelayer_cosmos_msgs_relayed_total 1")
+	require.Contains(t, string(body), "ebrelayer_cosmos_broadcast_latency_seconds_count 1")
+	require.Contains(t, string(body), "ebrelayer_cosmos_account_sequence 7")
+	require.Equal(t, "seen: 1, parsed: 1, relayed: 1, parse failures: 0, relay failures: 0", metrics.String())
+
+	var report HealthReport
+	res, err = http.Get(server.URL + "/health")
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&report))
+	res.Body.Close()
+	require.True(t, report.Healthy)
+
+	cosmosErr = errors.New("connection refused")
+	res, err = http.Get(server.URL + "/health")
+	require.NoError(t, err)
+	require.Equal(t, http.StatusServiceUnavailable, res.StatusCode)
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&report))
+	res.Body.Close()
+	require.False(t, report.Healthy)
+	require.Equal(t, "connection refused", report.Cosmos.Error)
+}
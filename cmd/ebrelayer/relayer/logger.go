@@ -0,0 +1,28 @@
+package relayer
+
+// ------------------------------------------------------------
+//    Logger
+//
+//    Builds the relayer's logger, which is passed to each of
+//    its modules with the module's name as context.
+// ------------------------------------------------------------
+
+import (
+	"io"
+
+	tmflags "github.com/tendermint/tendermint/libs/cli/flags"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/swishlabsco/cosmos-ethereum-bridge/cmd/ebrelayer/config"
+)
+
+// NewLogger returns a logger writing to w in the configured format, filtered by the configured levels
+func NewLogger(cfg config.LogConfig, w io.Writer) (log.Logger, error) {
+	var logger log.Logger
+	if cfg.Format == config.LogFormatJSON {
+		logger = log.NewTMJSONLogger(log.NewSyncWriter(w))
+	} else {
+		logger = log.NewTMLogger(log.NewSyncWriter(w))
+	}
+	return tmflags.ParseLogLevel(cfg.Level, logger, config.DefaultLogLevel)
+}
@@ -0,0 +1,34 @@
+package relayer
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/swishlabsco/cosmos-ethereum-bridge/cmd/ebrelayer/config"
+)
+
+func TestNewLogger(t *testing.T) {
+	var buf bytes.Buffer
+	logger, err := NewLogger(config.LogConfig{Level: "txs:debug,*:info", Format: config.LogFormatJSON}, &buf)
+	require.NoError(t, err)
+
+	logger.With("module", "relayer").Debug("hidden")
+	logger.With("module", "txs").Debug("Broadcasting tx", "sequence", 7)
+	logger.With("module", "relayer").Info("Relayed msg", "prophecy_id", "3:1:0x7B95")
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 2)
+
+	var entry map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(lines[1]), &entry))
+	require.Equal(t, "info", entry["level"])
+	require.Equal(t, "Relayed msg", entry["_msg"])
+	require.Equal(t, "3:1:0x7B95", entry["prophecy_id"])
+
+	_, err = NewLogger(config.LogConfig{Level: "txs:verbose", Format: config.LogFormatPlain}, &buf)
+	require.Error(t, err)
+}
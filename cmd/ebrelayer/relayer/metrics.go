@@ -0,0 +1,187 @@
+package relayer
+
+// ------------------------------------------------------------
+//    Metrics
+//
+//    Counts events as they move through the relayer's
+//    parse and relay pipeline, and exports them along with
+//    the state of each chain connection to Prometheus.
+// ------------------------------------------------------------
+
+import (
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// MetricsNamespace prefixes the name of every metric exported by the relayer
+const MetricsNamespace = "ebrelayer"
+
+// Metrics holds counters for events processed by the relayer. Events on Ethereum are labelled with
+// the name of the watcher's network; the Cosmos side is shared by every watcher.
+type Metrics struct {
+	EventsSeen    uint64
+	EventsParsed  uint64
+	EventsRelayed uint64
+	ParseFailures uint64
+	RelayFailures uint64
+
+	registry *prometheus.Registry
+
+	eventsSeen           *prometheus.CounterVec
+	eventsParsed         *prometheus.CounterVec
+	parseFailures        *prometheus.CounterVec
+	pendingConfirmations *prometheus.GaugeVec
+	latestBlock          *prometheus.GaugeVec
+	reconnects           *prometheus.CounterVec
+	claimsSkipped        *prometheus.CounterVec
+	claimMismatches      *prometheus.CounterVec
+	verificationFailures *prometheus.CounterVec
+
+	eventsRelayed    prometheus.Counter
+	relayFailures    prometheus.Counter
+	broadcastLatency prometheus.Histogram
+	accountSequence  prometheus.Gauge
+}
+
+// NewMetrics returns a zeroed set of relayer metrics, registered on their own registry
+func NewMetrics() *Metrics {
+	networkCounter := func(name string, help string) *prometheus.CounterVec {
+		return prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: MetricsNamespace,
+			Subsystem: "ethereum",
+			Name:      name,
+			Help:      help,
+		}, []string{"network"})
+	}
+	networkGauge := func(name string, help string) *prometheus.GaugeVec {
+		return prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: MetricsNamespace,
+			Subsystem: "ethereum",
+			Name:      name,
+			Help:      help,
+		}, []string{"network"})
+	}
+
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+
+		eventsSeen:           networkCounter("events_seen_total", "Confirmed contract events seen."),
+		eventsParsed:         networkCounter("events_parsed_total", "Contract events parsed into msgs and queued for relay."),
+		parseFailures:        networkCounter("parse_failures_total", "Contract events which could not be decoded or parsed."),
+		pendingConfirmations: networkGauge("pending_confirmations", "Contract events waiting for confirmations."),
+		latestBlock:          networkGauge("latest_block", "Latest block processed."),
+		reconnects:           networkCounter("websocket_reconnects_total", "Reconnections to a websocket provider."),
+		claimsSkipped:        networkCounter("claims_skipped_total", "Claims not relayed since they could not change their prophecy."),
+		claimMismatches:      networkCounter("claim_mismatches_total", "Claims which differ from their prophecy's leading claim."),
+		verificationFailures: networkCounter("verification_failures_total", "Locks which did not match the contract's state on ethereum."),
+
+		eventsRelayed: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: MetricsNamespace,
+			Subsystem: "cosmos",
+			Name:      "msgs_relayed_total",
+			Help:      "Msgs committed on the Cosmos bridge.",
+		}),
+		relayFailures: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: MetricsNamespace,
+			Subsystem: "cosmos",
+			Name:      "relay_failures_total",
+			Help:      "Msgs which could not be queued or relayed.",
+		}),
+		broadcastLatency: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Namespace: MetricsNamespace,
+			Subsystem: "cosmos",
+			Name:      "broadcast_latency_seconds",
+			Help:      "Time taken to sign and broadcast a transaction until it is checked by the node.",
+			Buckets:   prometheus.ExponentialBuckets(0.05, 2, 10),
+		}),
+		accountSequence: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: MetricsNamespace,
+			Subsystem: "cosmos",
+			Name:      "account_sequence",
+			Help:      "Sequence of the validator's last transaction accepted by the node.",
+		}),
+	}
+
+	m.registry.MustRegister(
+		m.eventsSeen, m.eventsParsed, m.parseFailures, m.pendingConfirmations, m.latestBlock, m.reconnects,
+		m.claimsSkipped, m.claimMismatches, m.verificationFailures,
+		m.eventsRelayed, m.relayFailures, m.broadcastLatency, m.accountSequence,
+	)
+	return m
+}
+
+// Registry returns the registry the relayer's metrics are exported from
+func (m *Metrics) Registry() *prometheus.Registry {
+	return m.registry
+}
+
+func (m *Metrics) IncEventsSeen(network string) {
+	atomic.AddUint64(&m.EventsSeen, 1)
+	m.eventsSeen.WithLabelValues(network).Inc()
+}
+
+func (m *Metrics) IncEventsParsed(network string) {
+	atomic.AddUint64(&m.EventsParsed, 1)
+	m.eventsParsed.WithLabelValues(network).Inc()
+}
+
+func (m *Metrics) IncParseFailures(network string) {
+	atomic.AddUint64(&m.ParseFailures, 1)
+	m.parseFailures.WithLabelValues(network).Inc()
+}
+
+func (m *Metrics) SetPendingConfirmations(network string, pending int) {
+	m.pendingConfirmations.WithLabelValues(network).Set(float64(pending))
+}
+
+func (m *Metrics) SetLatestBlock(network string, block uint64) {
+	m.latestBlock.WithLabelValues(network).Set(float64(block))
+}
+
+func (m *Metrics) IncReconnects(network string) {
+	m.reconnects.WithLabelValues(network).Inc()
+}
+
+func (m *Metrics) IncClaimsSkipped(network string) {
+	m.claimsSkipped.WithLabelValues(network).Inc()
+}
+
+func (m *Metrics) IncClaimMismatches(network string) {
+	m.claimMismatches.WithLabelValues(network).Inc()
+}
+
+func (m *Metrics) IncVerificationFailures(network string) {
+	m.verificationFailures.WithLabelValues(network).Inc()
+}
+
+func (m *Metrics) IncEventsRelayed() {
+	atomic.AddUint64(&m.EventsRelayed, 1)
+	m.eventsRelayed.Inc()
+}
+
+func (m *Metrics) IncRelayFailures() {
+	atomic.AddUint64(&m.RelayFailures, 1)
+	m.relayFailures.Inc()
+}
+
+// ObserveBroadcastLatency records the time taken by a broadcast, implementing txs.WorkerMetrics
+func (m *Metrics) ObserveBroadcastLatency(latency time.Duration) {
+	m.broadcastLatency.Observe(latency.Seconds())
+}
+
+// SetAccountSequence records the sequence of the last accepted transaction, implementing txs.WorkerMetrics
+func (m *Metrics) SetAccountSequence(sequence uint64) {
+	m.accountSequence.Set(float64(sequence))
+}
+
+func (m *Metrics) String() string {
+	return fmt.Sprintf("seen: %d, parsed: %d, relayed: %d, parse failures: %d, relay failures: %d",
+		atomic.LoadUint64(&m.EventsSeen),
+		atomic.LoadUint64(&m.EventsParsed),
+		atomic.LoadUint64(&m.EventsRelayed),
+		atomic.LoadUint64(&m.ParseFailures),
+		atomic.LoadUint64(&m.RelayFailures))
+}
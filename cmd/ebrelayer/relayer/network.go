@@ -12,14 +12,13 @@ import (
 	"net/url"
 
 	"github.com/ethereum/go-ethereum/ethclient"
-	log "github.com/golang/glog"
+	"github.com/tendermint/tendermint/libs/log"
 )
 
 // IsWebsocketURL return true if the given URL is a websocket URL
 func IsWebsocketURL(rawurl string) bool {
 	u, err := url.Parse(rawurl)
 	if err != nil {
-		log.Infof("Error while parsing URL: %v", err)
 		return false
 	}
 	if u.Scheme == "ws" || u.Scheme == "wss" {
@@ -43,8 +42,23 @@ func SetupWebsocketEthClient(ethURL string) (*ethclient.Client, error) {
 
 	client, err := ethclient.Dial(ethURL)
 	if err != nil {
-		return nil, fmt.Errorf("error dialing websocket client")
+		return nil, fmt.Errorf("error dialing websocket client: %v", err)
 	}
 
 	return client, nil
 }
+
+// SetupWebsocketEthClients dials each provider in order, returning the first client which connects
+func SetupWebsocketEthClients(logger log.Logger, providers []string) (*ethclient.Client, string, error) {
+	for _, provider := range providers {
+		client, err := SetupWebsocketEthClient(provider)
+		if err != nil {
+			logger.Error("Error connecting to provider", "provider", provider, "err", err)
+			continue
+		}
+		if client != nil {
+			return client, provider, nil
+		}
+	}
+	return nil, "", fmt.Errorf("unable to connect to any ethereum provider")
+}
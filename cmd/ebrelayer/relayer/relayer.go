@@ -12,109 +12,171 @@ package relayer
 import (
 	"context"
 	"fmt"
-	"log"
+	"net"
+	"sync"
 
 	amino "github.com/tendermint/go-amino"
+	"github.com/tendermint/tendermint/libs/log"
 
-	sdkContext "github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/client/keys"
-	authtxb "github.com/cosmos/cosmos-sdk/x/auth/client/txbuilder"
 
-	"github.com/ethereum/go-ethereum"
-	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 
-	"github.com/swishlabsco/cosmos-ethereum-bridge/cmd/ebrelayer/contract"
+	"github.com/swishlabsco/cosmos-ethereum-bridge/cmd/ebrelayer/config"
 	"github.com/swishlabsco/cosmos-ethereum-bridge/cmd/ebrelayer/events"
+	"github.com/swishlabsco/cosmos-ethereum-bridge/cmd/ebrelayer/signer"
 	"github.com/swishlabsco/cosmos-ethereum-bridge/cmd/ebrelayer/txs"
 )
 
 // -------------------------------------------------------------------------
-// Starts an event listener on a specific network, contract, and event
+// Starts an event listener on each configured network and contract
 // -------------------------------------------------------------------------
 
-func InitRelayer(cdc *amino.Codec, chainId string, provider string,
-	contractAddress common.Address, eventSig string,
-	validatorFrom string) error {
+func InitRelayer(logger log.Logger, cdc *amino.Codec, cfg config.Config, contractABI abi.ABI) error {
+	relayerLogger := logger.With("module", "relayer")
+	events.SetLogger(logger.With("module", "events"))
 
-	validatorAddress, validatorName, err := sdkContext.GetFromFields(validatorFrom)
+	txSigner, err := NewSigner(cfg.Cosmos)
 	if err != nil {
-		fmt.Printf("failed to get from fields: %v", err)
+		relayerLogger.Error("Error loading signer", "err", err)
 		return err
 	}
-
-	passphrase, err := keys.GetPassphrase(validatorFrom)
+	validatorAddress := signer.Address(txSigner)
+	relayerLogger.Info("Signing claims as validator", "validator", validatorAddress, "signer", cfg.Cosmos.Signer)
+
+	// Relay claims from a pool of workers sharing the validator's account sequence
+	metrics := NewMetrics()
+	broadcaster := txs.NewCLIBroadcaster(cfg.Cosmos.ChainID, cdc, txSigner)
+	worker := txs.NewRelayWorker(broadcaster, txs.DefaultNumWorkers, txs.DefaultQueueSize,
+		txs.DefaultBatchSize, txs.DefaultRetryPolicy(), func(result txs.RelayResult) {
+			handleRelayResult(relayerLogger, result, metrics)
+		})
+	worker.SetMetrics(metrics)
+	worker.SetLogger(logger.With("module", "txs"))
+	err = worker.Start()
 	if err != nil {
+		relayerLogger.Error("Error starting relay worker", "err", err)
 		return err
 	}
+	defer worker.Stop()
 
-	//Test passhprase is correct
-	_, err = authtxb.MakeSignature(nil, validatorName, passphrase, authtxb.StdSignMsg{})
-	if err != nil {
-		fmt.Printf("passphrase error: %v", err)
+	// Run a watcher for each configured network. The relayer stops when any watcher fails, after the
+	// others have stopped so that nothing is queued on the worker once it has been stopped.
+	ctx, cancel := context.WithCancel(context.Background())
+	errs := make(chan error, len(cfg.Ethereum)+1)
+	var wg sync.WaitGroup
+
+	// The Cosmos node is healthy while the validator's account can be queried from it
+	health := NewHealth(func() error {
+		_, _, err := broadcaster.AccountInfo()
 		return err
+	})
+	for _, watcherCfg := range cfg.Ethereum {
+		health.AddNetwork(watcherCfg.Name)
 	}
 
-	// Start client with infura ropsten provider
-	client, err := SetupWebsocketEthClient(provider)
-	if err != nil {
-		fmt.Errorf("%s", err)
+	if cfg.Metrics.ListenAddress != "" {
+		listener, err := net.Listen("tcp", cfg.Metrics.ListenAddress)
+		if err != nil {
+			cancel()
+			relayerLogger.Error("Error listening for metrics", "err", err)
+			return err
+		}
+		relayerLogger.Info("Serving metrics and health", "address", listener.Addr())
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := ServeMetrics(ctx, listener, NewMetricsHandler(metrics, health)); err != nil {
+				errs <- fmt.Errorf("metrics server stopped: %v", err)
+			}
+		}()
 	}
-	fmt.Printf("\nStarted ethereum websocket with provider: %s", provider)
 
-	// We need the contract address in bytes[] for the query
-	query := ethereum.FilterQuery{
-		Addresses: []common.Address{contractAddress},
+	for _, watcherCfg := range cfg.Ethereum {
+		watcher := NewWatcher(watcherCfg, contractABI, validatorAddress, worker, broadcaster, metrics, health,
+			relayerLogger)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs <- fmt.Errorf("watcher %s stopped: %v", watcher.Name(), watcher.Run(ctx))
+		}()
 	}
 
-	// We will check logs for new events
-	logs := make(chan types.Log)
+	err = <-errs
+	relayerLogger.Error("Stopping relayer", "err", err)
+	cancel()
+	wg.Wait()
+	return err
+}
 
-	// Filter by contract and event, write results to logs
-	sub, err := client.SubscribeFilterLogs(context.Background(), query, logs)
-	if err != nil {
-		fmt.Errorf("%s", err)
-	} else {
-		fmt.Printf("\nSubscribed to contract events on address: %s\n", contractAddress.Hex())
+// NewSigner returns the signer described by the config. A keyring signer unlocks the validator's key once,
+// reading the passphrase from a file or environment variable if one is configured and prompting otherwise.
+func NewSigner(cfg config.CosmosConfig) (signer.Signer, error) {
+	switch cfg.Signer {
+	case config.SignerRemote:
+		remoteSigner, err := signer.NewRemoteSigner(cfg.SignerSocket, signer.DefaultRemoteTimeout)
+		if err != nil {
+			return nil, err
+		}
+		return remoteSigner, nil
+	case config.SignerKeyring:
+		kb, err := keys.NewKeyBaseFromDir(cfg.Home)
+		if err != nil {
+			return nil, err
+		}
+		defer kb.CloseDB()
+
+		if _, err := kb.Get(cfg.Key); err != nil {
+			return nil, err
+		}
+
+		var passphrase []byte
+		switch {
+		case cfg.PassphraseFile != "":
+			passphrase, err = signer.PassphraseFromFile(cfg.PassphraseFile)
+		case cfg.PassphraseEnv != "":
+			passphrase, err = signer.PassphraseFromEnv(cfg.PassphraseEnv)
+		default:
+			passphrase, err = signer.PassphraseFromPrompt(cfg.Key)
+		}
+		if err != nil {
+			return nil, err
+		}
+		return signer.NewKeyringSigner(kb, cfg.Key, passphrase)
+	default:
+		return nil, fmt.Errorf("unknown signer %s", cfg.Signer)
 	}
+}
 
-	// Load Peggy Contract's ABI
-	contractABI := contract.LoadABI()
-
-	for {
-		select {
-		// Handle any errors
-		case err := <-sub.Err():
-			log.Fatal(err)
-		// vLog is raw event data
-		case vLog := <-logs:
-			// Check if the event is a 'LogLock' event
-			if vLog.Topics[0].Hex() == eventSig {
-				fmt.Printf("\n\nNew Lock Transaction:\nTx hash: %v\nBlock number: %v",
-					vLog.TxHash.Hex(), vLog.BlockNumber)
-
-				// Parse the event data into a new LockEvent using the contract's ABI
-				event := events.NewLockEvent(contractABI, "LogLock", vLog.Data)
-
-				// Add the event to the record
-				successfulStore := events.NewEventWrite(vLog.TxHash.Hex(), event)
-				if successfulStore != true {
-					fmt.Errorf("Error: event not stored")
-				}
-
-				// Parse the event's payload into a struct
-				claim, claimErr := txs.ParsePayload(validatorAddress, &event)
-				if claimErr != nil {
-					fmt.Errorf("Error: %s", claimErr)
-				}
-
-				// Initiate the relay
-				relayErr := txs.RelayEvent(chainId, cdc, validatorAddress, validatorName, passphrase, &claim)
-				if relayErr != nil {
-					fmt.Errorf("Error: %s", relayErr)
-				}
-			}
+// handleRelayResult records the final outcome of a relayed batch of claims and revocations
+func handleRelayResult(logger log.Logger, result txs.RelayResult, metrics *Metrics) {
+	outcome := events.RelayOutcome{
+		Status:    string(result.Outcome),
+		TxHash:    result.Response.TxHash,
+		Height:    result.Response.Height,
+		Code:      result.Response.Code,
+		Codespace: result.Response.Codespace,
+		Attempts:  result.Attempts,
+	}
+	if result.Err != nil {
+		outcome.Err = result.Err.Error()
+	}
+
+	for _, msg := range result.Msgs {
+		prophecyID := txs.MsgProphecyID(msg)
+		events.NewRelayOutcomeWrite(prophecyID, outcome)
+
+		msgLogger := logger.With("msg", msg.Type(), "prophecy_id", prophecyID, "cosmos_tx_hash", result.Response.TxHash,
+			"attempts", result.Attempts)
+		switch result.Outcome {
+		case txs.OutcomeCommitted:
+			metrics.IncEventsRelayed()
+			msgLogger.Info("Relayed msg", "height", result.Response.Height)
+		case txs.OutcomeAlreadyProcessed:
+			msgLogger.Info("Msg already processed", "err", result.Err)
+		default:
+			metrics.IncRelayFailures()
+			msgLogger.Error("Error relaying msg", "outcome", result.Outcome, "err", result.Err)
 		}
 	}
-	return fmt.Errorf("Error: Relayer timed out.")
 }
@@ -9,37 +9,98 @@ package relayer
 // ------------------------------------------------------------
 
 import (
-	"testing"
-	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
 	"strings"
-	"encoding/hex"
+	"testing"
 
-	"github.com/ethereum/go-ethereum/common"
+	"github.com/cosmos/cosmos-sdk/client/keys"
+	cryptokeys "github.com/cosmos/cosmos-sdk/crypto/keys"
 	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"github.com/tendermint/tendermint/libs/log"
+
 	app "github.com/swishlabsco/cosmos-ethereum-bridge"
+	"github.com/swishlabsco/cosmos-ethereum-bridge/cmd/ebrelayer/config"
+	"github.com/swishlabsco/cosmos-ethereum-bridge/cmd/ebrelayer/contract"
+	"github.com/swishlabsco/cosmos-ethereum-bridge/cmd/ebrelayer/signer"
 )
 
 const (
-	ChainID          = "testing"
-	Socket           = "wss://ropsten.infura.io/ws"
-	ContractAddress  =  "3de4ef81Ba6243A60B0a32d3BCeD4173b6EA02bb"
-	EventSig         = "0xe154a56f2d306d5bbe4ac2379cb0cfc906b23685047a2bd2f5f0a0e810888f72"
-	Validator        = "validator"
+	ChainID         = "testing"
+	Socket          = "wss://ropsten.infura.io/ws"
+	ContractAddress = "0x3de4ef81Ba6243A60B0a32d3BCeD4173b6EA02bb"
+	Validator       = "validator"
 )
 
 func TestInitRelayer(t *testing.T) {
 	cdc := app.MakeCodec()
 
-	// Parse the address of the deployed contract
-	bytesContractAddress, err := hex.DecodeString(ContractAddress)
-	if err != nil {
-		fmt.Printf("Invalid contract-address: %v", bytesContractAddress)
-	}
-	contractAddress := common.BytesToAddress(bytesContractAddress)
+	cfg := config.DefaultConfig()
+	cfg.Cosmos.ChainID = ChainID
+	cfg.Cosmos.Key = Validator
+	cfg.Ethereum[0].Providers = []string{Socket}
+	cfg.Ethereum[0].ContractAddress = ContractAddress
 
-	err = InitRelayer(cdc, ChainID, Socket, contractAddress, EventSig, Validator)
+	contractABI, err := contract.LoadABI()
+	require.NoError(t, err)
+
+	err = InitRelayer(log.NewNopLogger(), cdc, cfg, contractABI)
 
 	//TODO: add validator key processing for relayer init
 	require.Error(t, err)
 	require.True(t, strings.Contains(err.Error(), "Key validator not found"))
 }
+
+func TestNewKeyringSigner(t *testing.T) {
+	home, err := ioutil.TempDir("", "ebrelayer")
+	require.NoError(t, err)
+	defer os.RemoveAll(home)
+
+	kb, err := keys.NewKeyBaseFromDir(home)
+	require.NoError(t, err)
+	info, _, err := kb.CreateMnemonic(Validator, cryptokeys.English, "12345678", cryptokeys.Secp256k1)
+	require.NoError(t, err)
+	kb.CloseDB()
+
+	passphraseFile := filepath.Join(home, "passphrase")
+	require.NoError(t, ioutil.WriteFile(passphraseFile, []byte("12345678\n"), 0600))
+
+	cfg := config.DefaultConfig().Cosmos
+	cfg.Home = home
+	cfg.Key = Validator
+	cfg.PassphraseFile = passphraseFile
+
+	txSigner, err := NewSigner(cfg)
+	require.NoError(t, err)
+	require.Equal(t, info.GetAddress(), signer.Address(txSigner))
+
+	// A wrong passphrase is rejected on start-up rather than when the first claim is signed
+	require.NoError(t, ioutil.WriteFile(passphraseFile, []byte("87654321\n"), 0600))
+	_, err = NewSigner(cfg)
+	require.Error(t, err)
+}
+
+func TestNewRemoteSigner(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ebrelayer")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	socket := filepath.Join(dir, "signer.sock")
+	listener, err := net.Listen("unix", socket)
+	require.NoError(t, err)
+	defer listener.Close()
+
+	local := signer.NewPrivKeySigner(secp256k1.GenPrivKey())
+	go signer.ServeSigner(listener, local)
+
+	cfg := config.DefaultConfig().Cosmos
+	cfg.Signer = config.SignerRemote
+	cfg.SignerSocket = socket
+
+	txSigner, err := NewSigner(cfg)
+	require.NoError(t, err)
+	require.Equal(t, signer.Address(local), signer.Address(txSigner))
+}
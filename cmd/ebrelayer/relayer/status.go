@@ -0,0 +1,72 @@
+package relayer
+
+// ------------------------------------------------------------
+//    Status
+//
+//    Tracks whether locking on the bridge contract is active
+//    and raises alerts when it changes.
+// ------------------------------------------------------------
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// ContractStatus holds the last known active state of the bridge contract
+type ContractStatus struct {
+	mtx         sync.RWMutex
+	known       bool
+	active      bool
+	blockNumber uint64
+}
+
+// NewContractStatus returns a ContractStatus whose state is not yet known
+func NewContractStatus() *ContractStatus {
+	return &ContractStatus{}
+}
+
+// Update records the contract's active state as of the given block, ignoring updates from earlier
+// blocks. It returns true if the state changed from a previously known state.
+func (s *ContractStatus) Update(active bool, blockNumber uint64) bool {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if s.known && blockNumber < s.blockNumber {
+		return false
+	}
+	changed := s.known && s.active != active
+
+	s.known = true
+	s.active = active
+	s.blockNumber = blockNumber
+	return changed
+}
+
+// IsActive returns the contract's last known active state, and whether it is known at all
+func (s *ContractStatus) IsActive() (bool, bool) {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+	return s.active, s.known
+}
+
+func (s *ContractStatus) String() string {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+
+	switch {
+	case !s.known:
+		return "unknown"
+	case s.active:
+		return fmt.Sprintf("active since block %d", s.blockNumber)
+	default:
+		return fmt.Sprintf("paused since block %d", s.blockNumber)
+	}
+}
+
+// Alert reports a condition which needs an operator's attention, as an error marked with alert=true
+// so that it can be picked out of the logs
+func Alert(logger log.Logger, msg string, keyvals ...interface{}) {
+	logger.Error(msg, append([]interface{}{"alert", true}, keyvals...)...)
+}
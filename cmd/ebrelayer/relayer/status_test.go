@@ -0,0 +1,32 @@
+package relayer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestContractStatus(t *testing.T) {
+	status := NewContractStatus()
+	_, known := status.IsActive()
+	require.False(t, known)
+	require.Equal(t, "unknown", status.String())
+
+	// The first known state is not a change
+	require.False(t, status.Update(true, 10))
+	active, known := status.IsActive()
+	require.True(t, known)
+	require.True(t, active)
+
+	require.True(t, status.Update(false, 20))
+	require.Equal(t, "paused since block 20", status.String())
+
+	// Updates from earlier blocks are ignored
+	require.False(t, status.Update(true, 15))
+	active, _ = status.IsActive()
+	require.False(t, active)
+
+	require.False(t, status.Update(false, 25))
+	require.True(t, status.Update(true, 30))
+	require.Equal(t, "active since block 30", status.String())
+}
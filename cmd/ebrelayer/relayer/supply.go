@@ -0,0 +1,101 @@
+package relayer
+
+// ------------------------------------------------------------
+//    Supply
+//
+//    Compares the coins minted by the Cosmos bridge with the
+//    ether locked in the bridge contracts, to detect drift
+//    between the two.
+// ------------------------------------------------------------
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/swishlabsco/cosmos-ethereum-bridge/cmd/ebrelayer/config"
+	"github.com/swishlabsco/cosmos-ethereum-bridge/cmd/ebrelayer/txs"
+)
+
+// BalanceReader reads the ether balance of an account at the latest block, as ethclient.Client does
+type BalanceReader interface {
+	BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error)
+}
+
+// LockedBalance is the ether held by the bridge contract watched on a network
+type LockedBalance struct {
+	Network         string
+	ContractAddress common.Address
+	Balance         *big.Int
+}
+
+// ReadLockedBalance reads the ether held by a network's bridge contract
+func ReadLockedBalance(ctx context.Context, reader BalanceReader, network string, contractAddress common.Address) (LockedBalance, error) {
+	balance, err := reader.BalanceAt(ctx, contractAddress, nil)
+	if err != nil {
+		return LockedBalance{}, fmt.Errorf("failed to read balance of contract %s on %s: %v", contractAddress.Hex(), network, err)
+	}
+	return LockedBalance{Network: network, ContractAddress: contractAddress, Balance: balance}, nil
+}
+
+// ReadLockedBalances connects to each configured network and reads the ether held by its bridge contract
+func ReadLockedBalances(ctx context.Context, logger log.Logger, networks []config.EthereumConfig) ([]LockedBalance, error) {
+	var balances []LockedBalance
+	for _, network := range networks {
+		client, _, err := SetupWebsocketEthClients(logger, network.Providers)
+		if err != nil {
+			return nil, fmt.Errorf("network %s: %v", network.Name, err)
+		}
+		balance, err := ReadLockedBalance(ctx, client, network.Name, common.HexToAddress(network.ContractAddress))
+		client.Close()
+		if err != nil {
+			return nil, err
+		}
+		balances = append(balances, balance)
+	}
+	return balances, nil
+}
+
+// SupplyReport compares the bridged supply of the denom locks are minted as with the ether locked in the bridge
+// contracts
+type SupplyReport struct {
+	Denom       string
+	Bridged     *big.Int
+	Locked      []LockedBalance
+	TotalLocked *big.Int
+	// Drift is the locked ether less the bridged supply. It is positive while locks wait to be minted, and
+	// negative when coins are in circulation which no lock backs.
+	Drift *big.Int
+}
+
+// NewSupplyReport compares the bridged supply of txs.BridgedDenom with the balances of the bridge contracts
+func NewSupplyReport(bridgedSupply sdk.Coins, locked []LockedBalance) SupplyReport {
+	totalLocked := new(big.Int)
+	for _, balance := range locked {
+		totalLocked.Add(totalLocked, balance.Balance)
+	}
+	bridged := bridgedSupply.AmountOf(txs.BridgedDenom).BigInt()
+	return SupplyReport{
+		Denom:       txs.BridgedDenom,
+		Bridged:     bridged,
+		Locked:      locked,
+		TotalLocked: totalLocked,
+		Drift:       new(big.Int).Sub(totalLocked, bridged),
+	}
+}
+
+func (r SupplyReport) String() string {
+	var sb strings.Builder
+	for _, balance := range r.Locked {
+		fmt.Fprintf(&sb, "locked on %s (%s): %s\n", balance.Network, balance.ContractAddress.Hex(), balance.Balance)
+	}
+	fmt.Fprintf(&sb, "total locked: %s\n", r.TotalLocked)
+	fmt.Fprintf(&sb, "bridged %s: %s\n", r.Denom, r.Bridged)
+	fmt.Fprintf(&sb, "drift: %s", r.Drift)
+	return sb.String()
+}
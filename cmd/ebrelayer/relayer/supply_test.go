@@ -0,0 +1,58 @@
+package relayer
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+// balanceReader serves fixed balances, failing for unknown accounts
+type balanceReader map[common.Address]*big.Int
+
+func (r balanceReader) BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error) {
+	balance, ok := r[account]
+	if !ok {
+		return nil, errors.New("unknown account")
+	}
+	return balance, nil
+}
+
+func TestReadLockedBalance(t *testing.T) {
+	contract := common.HexToAddress(ContractAddress)
+	reader := balanceReader{contract: big.NewInt(42)}
+
+	balance, err := ReadLockedBalance(context.Background(), reader, "ropsten", contract)
+	require.NoError(t, err)
+	require.Equal(t, LockedBalance{Network: "ropsten", ContractAddress: contract, Balance: big.NewInt(42)}, balance)
+
+	_, err = ReadLockedBalance(context.Background(), reader, "ropsten", common.HexToAddress(TestSender))
+	require.Error(t, err)
+}
+
+func TestSupplyReport(t *testing.T) {
+	contract := common.HexToAddress(ContractAddress)
+	locked := []LockedBalance{
+		{Network: "ropsten", ContractAddress: contract, Balance: big.NewInt(30)},
+		{Network: "rinkeby", ContractAddress: contract, Balance: big.NewInt(12)},
+	}
+
+	// Locks waiting to be minted leave more ether locked than bridged
+	report := NewSupplyReport(sdk.Coins{sdk.NewInt64Coin("ethereum", 40), sdk.NewInt64Coin("stake", 100)}, locked)
+	require.Equal(t, big.NewInt(42), report.TotalLocked)
+	require.Equal(t, big.NewInt(40), report.Bridged)
+	require.Equal(t, big.NewInt(2), report.Drift)
+	require.Contains(t, report.String(), "drift: 2")
+
+	// Coins which no lock backs drift below zero
+	report = NewSupplyReport(sdk.Coins{sdk.NewInt64Coin("ethereum", 50)}, locked)
+	require.Equal(t, big.NewInt(-8), report.Drift)
+
+	// Nothing bridged and nothing locked
+	report = NewSupplyReport(nil, nil)
+	require.Equal(t, 0, report.Drift.Sign())
+}
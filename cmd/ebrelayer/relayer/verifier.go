@@ -0,0 +1,159 @@
+package relayer
+
+// ------------------------------------------------------------
+//    Verifier
+//
+//    Verifies locks against Ethereum independently of the
+//    event subscription, by fetching the receipt of the
+//    lock's transaction and the item held by the contract,
+//    so that a validator only attests to locks it has
+//    checked itself.
+// ------------------------------------------------------------
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/swishlabsco/cosmos-ethereum-bridge/cmd/ebrelayer/contract"
+	"github.com/swishlabsco/cosmos-ethereum-bridge/cmd/ebrelayer/events"
+)
+
+// VerifierBackend is the Ethereum node which locks are verified against
+type VerifierBackend interface {
+	ethereum.ContractCaller
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+	TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
+}
+
+// VerificationError is returned when a lock does not match the state of Ethereum, so it must not be claimed.
+// Other errors returned by the verifier mean that the lock could not be verified yet.
+type VerificationError struct {
+	Reason string
+}
+
+func (err VerificationError) Error() string {
+	return fmt.Sprintf("lock failed verification: %s", err.Reason)
+}
+
+func errVerification(format string, args ...interface{}) error {
+	return VerificationError{Reason: fmt.Sprintf(format, args...)}
+}
+
+// IsVerificationError returns true if the error means that a lock does not match the state of Ethereum
+func IsVerificationError(err error) bool {
+	_, ok := err.(VerificationError)
+	return ok
+}
+
+// Verifier verifies locks made on the Peggy contract of one Ethereum network
+type Verifier struct {
+	contractABI     abi.ABI
+	contractAddress common.Address
+	confirmations   uint64
+	logger          log.Logger
+}
+
+// NewVerifier returns a verifier of locks on the contract which have at least the given number of confirmations
+func NewVerifier(contractABI abi.ABI, contractAddress common.Address, confirmations uint64, logger log.Logger) Verifier {
+	return Verifier{
+		contractABI:     contractABI,
+		contractAddress: contractAddress,
+		confirmations:   confirmations,
+		logger:          logger,
+	}
+}
+
+// VerifyLock fetches the receipt of the transaction and finds the LogLock emitted by the contract with the given
+// nonce and sender, re-decoding it with the contract's ABI. The lock is verified once it has enough confirmations
+// and the contract still holds its item as locked, with the same sender, recipient, token, amount and nonce. It
+// returns the event and log as found in the receipt.
+func (v Verifier) VerifyLock(ctx context.Context, backend VerifierBackend, txHash common.Hash, nonce *big.Int,
+	sender common.Address) (events.LockEvent, types.Log, error) {
+
+	receipt, err := backend.TransactionReceipt(ctx, txHash)
+	if err == ethereum.NotFound {
+		return events.LockEvent{}, types.Log{}, errVerification("transaction %s not found", txHash.Hex())
+	}
+	if err != nil {
+		return events.LockEvent{}, types.Log{}, err
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return events.LockEvent{}, types.Log{}, errVerification("transaction %s was reverted", txHash.Hex())
+	}
+
+	event, vLog, found := v.findLock(receipt, nonce, sender)
+	if !found {
+		return events.LockEvent{}, types.Log{}, errVerification("no lock with nonce %s from %s by contract %s in transaction %s",
+			nonce, sender.Hex(), v.contractAddress.Hex(), txHash.Hex())
+	}
+
+	head, err := backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return events.LockEvent{}, types.Log{}, err
+	}
+	if vLog.BlockNumber+v.confirmations > head.Number.Uint64() {
+		return events.LockEvent{}, types.Log{}, fmt.Errorf("lock in block %d does not have %d confirmations yet",
+			vLog.BlockNumber, v.confirmations)
+	}
+
+	locked, err := contract.GetStatus(ctx, v.logger, backend, v.contractABI, v.contractAddress, event.Id)
+	if err != nil {
+		return events.LockEvent{}, types.Log{}, err
+	}
+	if !locked {
+		return events.LockEvent{}, types.Log{}, errVerification("item %s is no longer locked", common.Hash(event.Id).Hex())
+	}
+
+	item, err := contract.ViewItem(ctx, v.logger, backend, v.contractABI, v.contractAddress, event.Id)
+	if err != nil {
+		return events.LockEvent{}, types.Log{}, err
+	}
+	if err := matchItem(item, event); err != nil {
+		return events.LockEvent{}, types.Log{}, err
+	}
+	return event, vLog, nil
+}
+
+// findLock returns the LogLock emitted by the contract in the receipt with the given nonce and sender. Logs from
+// other contracts are ignored, even if they have the same signature.
+func (v Verifier) findLock(receipt *types.Receipt, nonce *big.Int, sender common.Address) (events.LockEvent, types.Log, bool) {
+	for _, vLog := range receipt.Logs {
+		if vLog == nil || vLog.Address != v.contractAddress {
+			continue
+		}
+		event, err := events.DecodeLog(v.contractABI, *vLog)
+		if err != nil {
+			continue
+		}
+		lockEvent, ok := event.(events.LockEvent)
+		if ok && lockEvent.Nonce.Cmp(nonce) == 0 && lockEvent.From == sender {
+			return lockEvent, *vLog, true
+		}
+	}
+	return events.LockEvent{}, types.Log{}, false
+}
+
+// matchItem returns a VerificationError if the item held by the contract differs from the lock event
+func matchItem(item contract.Item, event events.LockEvent) error {
+	switch {
+	case item.Sender != event.From:
+		return errVerification("item sender %s differs from lock sender %s", item.Sender.Hex(), event.From.Hex())
+	case !bytes.Equal(item.Recipient, event.To):
+		return errVerification("item recipient %s differs from lock recipient %s", item.Recipient, event.To)
+	case item.Token != event.Token:
+		return errVerification("item token %s differs from lock token %s", item.Token.Hex(), event.Token.Hex())
+	case item.Amount == nil || item.Amount.Cmp(event.Value) != 0:
+		return errVerification("item amount %s differs from lock value %s", item.Amount, event.Value)
+	case item.Nonce == nil || item.Nonce.Cmp(event.Nonce) != 0:
+		return errVerification("item nonce %s differs from lock nonce %s", item.Nonce, event.Nonce)
+	}
+	return nil
+}
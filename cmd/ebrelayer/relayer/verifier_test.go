@@ -0,0 +1,195 @@
+package relayer
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/swishlabsco/cosmos-ethereum-bridge/cmd/ebrelayer/contract"
+	"github.com/swishlabsco/cosmos-ethereum-bridge/cmd/ebrelayer/events"
+	ethbridgeTypes "github.com/swishlabsco/cosmos-ethereum-bridge/x/ethbridge/types"
+)
+
+const (
+	TestSender    = "0xC8Ee928625908D90d4B60859052aD200CBe2792A"
+	TestRecipient = "cosmos1gn8409qq9hnrxde37kuxwx5hrxpfpv8426szuv"
+)
+
+// simulatedBackend is an in-memory stand-in for an ethereum node running the Peggy contract. It serves the
+// receipts of the locks made on it, and answers getStatus() and viewItem() calls ABI-encoded like the contract.
+type simulatedBackend struct {
+	contractABI     abi.ABI
+	contractAddress common.Address
+	head            uint64
+	receipts        map[common.Hash]*types.Receipt
+	items           map[[32]byte]contract.Item
+	locked          map[[32]byte]bool
+}
+
+func newSimulatedBackend(t *testing.T) *simulatedBackend {
+	contractABI, err := contract.LoadABI()
+	require.NoError(t, err)
+
+	return &simulatedBackend{
+		contractABI:     contractABI,
+		contractAddress: common.HexToAddress(ContractAddress),
+		receipts:        make(map[common.Hash]*types.Receipt),
+		items:           make(map[[32]byte]contract.Item),
+		locked:          make(map[[32]byte]bool),
+	}
+}
+
+// lock makes a lock on the contract in a transaction mined in the given block, returning its transaction hash
+// and item id
+func (b *simulatedBackend) lock(t *testing.T, nonce int64, value int64, block uint64) (common.Hash, [32]byte) {
+	item := contract.Item{
+		Sender:    common.HexToAddress(TestSender),
+		Recipient: []byte(TestRecipient),
+		Amount:    big.NewInt(value),
+		Nonce:     big.NewInt(nonce),
+	}
+	var id [32]byte
+	copy(id[:], crypto.Keccak256([]byte(fmt.Sprintf("item%d", nonce))))
+	txHash := crypto.Keccak256Hash([]byte(fmt.Sprintf("tx%d", nonce)))
+
+	event := b.contractABI.Events[events.LogLock]
+	data, err := event.Inputs.Pack(id, item.Sender, item.Recipient, item.Token, item.Amount, item.Nonce)
+	require.NoError(t, err)
+
+	b.receipts[txHash] = &types.Receipt{
+		Status: types.ReceiptStatusSuccessful,
+		TxHash: txHash,
+		Logs: []*types.Log{{
+			Address:     b.contractAddress,
+			Topics:      []common.Hash{event.Id()},
+			Data:        data,
+			BlockNumber: block,
+			TxHash:      txHash,
+		}},
+	}
+	b.items[id] = item
+	b.locked[id] = true
+	return txHash, id
+}
+
+func (b *simulatedBackend) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	if call.To == nil || *call.To != b.contractAddress {
+		return nil, nil
+	}
+	method, err := b.contractABI.MethodById(call.Data[:4])
+	if err != nil {
+		return nil, err
+	}
+	var id [32]byte
+	copy(id[:], call.Data[4:])
+
+	switch method.Name {
+	case "getStatus":
+		return method.Outputs.Pack(b.locked[id])
+	case "viewItem":
+		item, ok := b.items[id]
+		if !ok {
+			item = contract.Item{Amount: big.NewInt(0), Nonce: big.NewInt(0)}
+		}
+		return method.Outputs.Pack(item.Sender, item.Recipient, item.Token, item.Amount, item.Nonce)
+	default:
+		return nil, fmt.Errorf("unsupported method %s", method.Name)
+	}
+}
+
+func (b *simulatedBackend) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	return &types.Header{Number: new(big.Int).SetUint64(b.head)}, nil
+}
+
+func (b *simulatedBackend) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	receipt, ok := b.receipts[txHash]
+	if !ok {
+		return nil, ethereum.NotFound
+	}
+	return receipt, nil
+}
+
+func TestVerifyLock(t *testing.T) {
+	backend := newSimulatedBackend(t)
+	verifier := NewVerifier(backend.contractABI, backend.contractAddress, 6, log.NewNopLogger())
+	sender := common.HexToAddress(TestSender)
+	txHash, id := backend.lock(t, 1, 7, 100)
+	ctx := context.Background()
+
+	// The lock is not verified until it has enough confirmations, which is not a verification failure
+	backend.head = 105
+	_, _, err := verifier.VerifyLock(ctx, backend, txHash, big.NewInt(1), sender)
+	require.Error(t, err)
+	require.False(t, IsVerificationError(err))
+
+	backend.head = 106
+	event, vLog, err := verifier.VerifyLock(ctx, backend, txHash, big.NewInt(1), sender)
+	require.NoError(t, err)
+	require.Equal(t, id, event.Id)
+	require.Equal(t, sender, event.From)
+	require.Equal(t, []byte(TestRecipient), event.To)
+	require.Equal(t, big.NewInt(7), event.Value)
+	require.Equal(t, txHash, vLog.TxHash)
+
+	// Locks which are not in the transaction
+	_, _, err = verifier.VerifyLock(ctx, backend, txHash, big.NewInt(2), sender)
+	require.True(t, IsVerificationError(err))
+	_, _, err = verifier.VerifyLock(ctx, backend, txHash, big.NewInt(1), common.HexToAddress(ContractAddress))
+	require.True(t, IsVerificationError(err))
+	_, _, err = verifier.VerifyLock(ctx, backend, common.HexToHash("0x01"), big.NewInt(1), sender)
+	require.True(t, IsVerificationError(err))
+
+	// The item is no longer locked
+	backend.locked[id] = false
+	_, _, err = verifier.VerifyLock(ctx, backend, txHash, big.NewInt(1), sender)
+	require.True(t, IsVerificationError(err))
+	backend.locked[id] = true
+
+	// The item held by the contract differs from the event
+	item := backend.items[id]
+	item.Amount = big.NewInt(8)
+	backend.items[id] = item
+	_, _, err = verifier.VerifyLock(ctx, backend, txHash, big.NewInt(1), sender)
+	require.True(t, IsVerificationError(err))
+}
+
+func TestVerifyLockFromOtherContract(t *testing.T) {
+	backend := newSimulatedBackend(t)
+	verifier := NewVerifier(backend.contractABI, backend.contractAddress, 0, log.NewNopLogger())
+	sender := common.HexToAddress(TestSender)
+	txHash, _ := backend.lock(t, 1, 7, 100)
+	backend.head = 100
+
+	// A log with the same signature emitted by another contract is not a lock
+	backend.receipts[txHash].Logs[0].Address = common.HexToAddress("0x7B95B6EC7EbD73572298cEf32Bb54FA408207359")
+	_, _, err := verifier.VerifyLock(context.Background(), backend, txHash, big.NewInt(1), sender)
+	require.True(t, IsVerificationError(err))
+
+	// Nor is a lock made by a reverted transaction
+	backend.receipts[txHash].Logs[0].Address = backend.contractAddress
+	backend.receipts[txHash].Status = types.ReceiptStatusFailed
+	_, _, err = verifier.VerifyLock(context.Background(), backend, txHash, big.NewInt(1), sender)
+	require.True(t, IsVerificationError(err))
+}
+
+func TestClaimedTxHashes(t *testing.T) {
+	txHash := crypto.Keccak256Hash([]byte("tx1"))
+	otherTxHash := crypto.Keccak256Hash([]byte("tx2"))
+	claims := []ethbridgeTypes.EthBridgeClaim{
+		{EthereumProvenance: ethbridgeTypes.EthereumProvenance{TxHash: txHash.Hex()}},
+		{EthereumProvenance: ethbridgeTypes.EthereumProvenance{TxHash: ""}},
+		{EthereumProvenance: ethbridgeTypes.EthereumProvenance{TxHash: otherTxHash.Hex()}},
+		{EthereumProvenance: ethbridgeTypes.EthereumProvenance{TxHash: txHash.Hex()}},
+	}
+	require.Equal(t, []common.Hash{txHash, otherTxHash}, claimedTxHashes(claims))
+	require.Empty(t, claimedTxHashes(nil))
+}
@@ -0,0 +1,560 @@
+package relayer
+
+// -----------------------------------------------------
+//      Watcher
+//
+//      Watches the Peggy contract deployed on a single
+//      Ethereum network and queues claims on its events
+//      for relay to the Cosmos bridge.
+// -----------------------------------------------------
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/swishlabsco/cosmos-ethereum-bridge/cmd/ebrelayer/config"
+	"github.com/swishlabsco/cosmos-ethereum-bridge/cmd/ebrelayer/contract"
+	"github.com/swishlabsco/cosmos-ethereum-bridge/cmd/ebrelayer/events"
+	"github.com/swishlabsco/cosmos-ethereum-bridge/cmd/ebrelayer/txs"
+	"github.com/swishlabsco/cosmos-ethereum-bridge/x/ethbridge"
+	ethbridgeTypes "github.com/swishlabsco/cosmos-ethereum-bridge/x/ethbridge/types"
+)
+
+const (
+	// initialReconnectBackoff is the delay before reconnecting to a network, doubled after each failed attempt
+	initialReconnectBackoff = time.Second
+	// maxReconnectBackoff bounds the delay between attempts to reconnect to a network
+	maxReconnectBackoff = time.Minute
+
+	// verifyInterval is how often a watcher in verify mode checks the pending prophecies on its network
+	verifyInterval = 30 * time.Second
+	// queuedClaimTTL is how long a queued claim is expected to take to reach the chain, during which its
+	// prophecy is not claimed again by the verifier
+	queuedClaimTTL = 10 * time.Minute
+)
+
+// unverifiedLock is a confirmed lock which could not be verified yet, and is retried by the verifier
+type unverifiedLock struct {
+	vLog  types.Log
+	event events.LockEvent
+}
+
+// Watcher relays the events of the Peggy contract on one Ethereum network. Watchers on different
+// networks share the validator's relay worker, and tag their claims with their network's chain id.
+type Watcher struct {
+	cfg              config.EthereumConfig
+	contractABI      abi.ABI
+	contractAddress  common.Address
+	validatorAddress sdk.AccAddress
+	worker           *txs.RelayWorker
+	querier          txs.ProphecyQuerier
+	status           *ContractStatus
+	metrics          *Metrics
+	health           *Health
+	logger           log.Logger
+
+	// In verify mode, claims are only made on locks which the verifier has checked against the contract.
+	// The maps are only accessed from the goroutine running the watcher.
+	verifier   Verifier
+	unverified map[string]unverifiedLock
+	rejected   map[string]bool
+	queued     map[string]time.Time
+}
+
+// NewWatcher returns a watcher for the network described by the given config
+func NewWatcher(cfg config.EthereumConfig, contractABI abi.ABI, validatorAddress sdk.AccAddress,
+	worker *txs.RelayWorker, querier txs.ProphecyQuerier, metrics *Metrics, health *Health, logger log.Logger) *Watcher {
+
+	logger = logger.With("network", cfg.Name, "chain_id", cfg.ChainID)
+	contractAddress := common.HexToAddress(cfg.ContractAddress)
+	return &Watcher{
+		cfg:              cfg,
+		contractABI:      contractABI,
+		contractAddress:  contractAddress,
+		validatorAddress: validatorAddress,
+		worker:           worker,
+		querier:          querier,
+		status:           NewContractStatus(),
+		metrics:          metrics,
+		health:           health,
+		logger:           logger,
+		verifier:         NewVerifier(contractABI, contractAddress, cfg.Confirmations, logger),
+		unverified:       make(map[string]unverifiedLock),
+		rejected:         make(map[string]bool),
+		queued:           make(map[string]time.Time),
+	}
+}
+
+// Name returns the name of the watcher's network
+func (w *Watcher) Name() string {
+	return w.cfg.Name
+}
+
+// Run connects to the network and relays confirmed events until the context is cancelled. When the
+// connection is lost the watcher reconnects with backoff, replaying the events it may have missed, but
+// an error connecting for the first time is returned since it is likely to be a misconfiguration.
+func (w *Watcher) Run(ctx context.Context) error {
+	pending := NewPendingLogs(w.cfg.Confirmations)
+	fromBlock := w.cfg.StartBlock
+	backoff := initialReconnectBackoff
+	connected := false
+
+	for {
+		subscribed, latestBlock, err := w.watch(ctx, pending, fromBlock)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if !connected && !subscribed {
+			return err
+		}
+		connected = true
+		if subscribed {
+			backoff = initialReconnectBackoff
+		}
+
+		// Replay from the last block seen so that events emitted while disconnected are relayed.
+		// Events which were already pending or relayed are ignored.
+		if latestBlock > fromBlock {
+			fromBlock = latestBlock
+		}
+
+		w.health.SetDisconnected(w.Name(), err)
+		w.logger.Error("Lost connection to ethereum, reconnecting", "err", err, "backoff", backoff)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+		w.metrics.IncReconnects(w.Name())
+
+		backoff *= 2
+		if backoff > maxReconnectBackoff {
+			backoff = maxReconnectBackoff
+		}
+	}
+}
+
+// watch subscribes to the contract through the first available provider and relays confirmed events until
+// the subscription fails or the context is cancelled. It returns whether the subscription was made and the
+// latest block seen, which events are replayed from after reconnecting.
+func (w *Watcher) watch(ctx context.Context, pending *PendingLogs, fromBlock uint64) (bool, uint64, error) {
+	// Start client with the first available provider
+	client, provider, err := SetupWebsocketEthClients(w.logger, w.cfg.Providers)
+	if err != nil {
+		return false, fromBlock, err
+	}
+	defer client.Close()
+	w.logger.Info("Started ethereum websocket", "provider", provider)
+
+	// We need the contract address in bytes[] for the query
+	query := ethereum.FilterQuery{
+		Addresses: []common.Address{w.contractAddress},
+	}
+
+	// We will check logs for new events
+	logs := make(chan types.Log)
+
+	// Filter by contract and event, write results to logs
+	sub, err := client.SubscribeFilterLogs(ctx, query, logs)
+	if err != nil {
+		return false, fromBlock, err
+	}
+	defer sub.Unsubscribe()
+	w.logger.Info("Subscribed to contract events", "contract", w.contractAddress.Hex())
+
+	// New block headers release logs once they have enough confirmations
+	heads := make(chan *types.Header)
+	headSub, err := client.SubscribeNewHead(ctx, heads)
+	if err != nil {
+		return false, fromBlock, err
+	}
+	defer headSub.Unsubscribe()
+
+	head, err := client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return false, fromBlock, err
+	}
+	latestBlock := head.Number.Uint64()
+
+	// Find whether locking is currently active on the contract
+	active, err := contract.IsActive(ctx, w.logger, client, w.contractABI, w.contractAddress)
+	if err != nil {
+		return false, fromBlock, err
+	}
+	w.status.Update(active, latestBlock)
+	w.logger.Info("Found bridge contract status", "status", w.status)
+	if !active {
+		Alert(w.logger, "Bridge contract is paused, no new locks can be made", "contract", w.contractAddress.Hex())
+	}
+
+	// Replay events emitted since the configured start block, or since the connection was lost
+	if fromBlock > 0 {
+		query.FromBlock = new(big.Int).SetUint64(fromBlock)
+		pastLogs, err := client.FilterLogs(ctx, query)
+		if err != nil {
+			return false, fromBlock, err
+		}
+		w.logger.Info("Replaying events", "events", len(pastLogs), "from_block", fromBlock)
+		for _, vLog := range pastLogs {
+			pending.Add(vLog)
+		}
+	}
+
+	w.health.SetConnected(w.Name(), provider)
+	w.health.SetLatestBlock(w.Name(), latestBlock)
+
+	// In verify mode the pending prophecies on the network are checked periodically, so that locks claimed by
+	// other validators are claimed once they are verified
+	var verifyTicks <-chan time.Time
+	if w.cfg.Verify {
+		w.logger.Info("Verifying locks against the contract before claiming them")
+		ticker := time.NewTicker(verifyInterval)
+		defer ticker.Stop()
+		verifyTicks = ticker.C
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return true, latestBlock, ctx.Err()
+		// Handle any errors
+		case err := <-sub.Err():
+			return true, latestBlock, err
+		case err := <-headSub.Err():
+			return true, latestBlock, err
+		// vLog is raw event data
+		case vLog := <-logs:
+			pending.Add(vLog)
+		case head := <-heads:
+			latestBlock = head.Number.Uint64()
+			w.health.SetLatestBlock(w.Name(), latestBlock)
+		case <-verifyTicks:
+			w.verifyPending(ctx, client)
+		}
+
+		for _, vLog := range pending.Confirmed(latestBlock) {
+			w.processLog(ctx, client, vLog)
+		}
+		w.metrics.SetLatestBlock(w.Name(), latestBlock)
+		w.metrics.SetPendingConfirmations(w.Name(), pending.Len())
+	}
+}
+
+// processLog decodes a confirmed log and dispatches it to the handler for its event. Logs which
+// cannot be decoded are written to the dead letter records so that processing continues.
+func (w *Watcher) processLog(ctx context.Context, backend VerifierBackend, vLog types.Log) {
+	txHash := vLog.TxHash.Hex()
+	logger := w.logger.With("tx_hash", txHash, "block", vLog.BlockNumber, "log_index", vLog.Index)
+	w.metrics.IncEventsSeen(w.Name())
+
+	event, err := events.DecodeLog(w.contractABI, vLog)
+	if err != nil {
+		w.metrics.IncParseFailures(w.Name())
+		events.NewDeadLetterWrite(txHash, vLog.Index, vLog.BlockNumber, "", vLog.Data, err)
+		logger.Error("Error decoding event", "err", err)
+		return
+	}
+	logger = logger.With("event", event.EventName())
+
+	switch event := event.(type) {
+	case events.LockEvent:
+		logger = logger.With("nonce", event.Nonce, "sender", event.From.Hex(), "token", event.Token.Hex(), "value", event.Value)
+		logger.Info("New lock transaction")
+		if active, known := w.status.IsActive(); known && !active {
+			Alert(logger, "Lock was made while the bridge contract is paused")
+		}
+
+		err = w.handleLockEvent(ctx, backend, logger, vLog, event)
+	case events.WithdrawEvent, events.UnlockEvent:
+		logger.Info("New revocation transaction")
+		err = w.handleRevocationEvent(logger, vLog, event)
+	case events.LockingPausedEvent, events.LockingActivatedEvent:
+		logger.Info("New bridge status event")
+		err = w.handleStatusEvent(logger, vLog, event)
+	default:
+		logger.Info("New event")
+	}
+	if err != nil {
+		logger.Error("Error processing event", "err", err)
+	}
+	logger.Debug("Processed event", "contract_status", w.status, "metrics", w.metrics)
+}
+
+// handleLockEvent queues a claim on a LockEvent for relay. In verify mode the lock is first verified against
+// the contract, and locks which cannot be verified yet are retried by the verifier.
+func (w *Watcher) handleLockEvent(ctx context.Context, backend VerifierBackend, logger log.Logger, vLog types.Log,
+	event events.LockEvent) error {
+
+	// Add the event to the record
+	events.NewEventWrite(vLog.TxHash.Hex(), event)
+
+	if w.cfg.Verify {
+		verifiedEvent, verifiedLog, err := w.verifier.VerifyLock(ctx, backend, vLog.TxHash, event.Nonce, event.From)
+		if IsVerificationError(err) {
+			w.metrics.IncVerificationFailures(w.Name())
+			events.NewDeadLetterWrite(vLog.TxHash.Hex(), vLog.Index, vLog.BlockNumber, events.LogLock, vLog.Data, err)
+			Alert(logger, "Lock failed verification, not claiming it", "err", err)
+			return nil
+		}
+		if err != nil {
+			w.unverified[logKey(vLog)] = unverifiedLock{vLog: vLog, event: event}
+			return fmt.Errorf("could not verify lock, retrying later: %v", err)
+		}
+		vLog, event = verifiedLog, verifiedEvent
+	}
+
+	return w.relayLockClaim(logger, vLog, event)
+}
+
+// relayLockClaim parses a LockEvent into a claim and queues it for relay. Claims which cannot be
+// parsed are written to the dead letter records so that processing continues with later events.
+func (w *Watcher) relayLockClaim(logger log.Logger, vLog types.Log, event events.LockEvent) error {
+	txHash := vLog.TxHash.Hex()
+
+	// Parse the event's payload into a struct
+	claim, err := txs.ParsePayload(w.cfg.ChainID, w.validatorAddress, vLog, &event)
+	if payloadErr, ok := err.(txs.PayloadError); ok && payloadErr.Code == txs.CodeInvalidRecipient {
+		// The lock's coins cannot be minted to its recipient, so claim that it was made to an invalid receiver
+		// for the bridge to make it refundable to the ethereum sender
+		logger.Info("Lock recipient is not a valid cosmos address, claiming an invalid receiver", "err", err)
+		claim, err = txs.ParseInvalidReceiverPayload(w.cfg.ChainID, w.validatorAddress, vLog, &event)
+	}
+	if err != nil {
+		w.metrics.IncParseFailures(w.Name())
+		events.NewDeadLetterWrite(txHash, vLog.Index, vLog.BlockNumber, events.LogLock, vLog.Data, err)
+		return err
+	}
+	w.metrics.IncEventsParsed(w.Name())
+	prophecyID := txs.ProphecyID(claim)
+	logger = logger.With("prophecy_id", prophecyID)
+
+	// Check the prophecy first, so that claims which would be rejected are not paid for. The claim is
+	// relayed when the check fails, since the bridge rejects claims which cannot change the prophecy anyway.
+	check, err := txs.CheckClaim(w.querier, claim)
+	if err != nil {
+		logger.Error("Error checking prophecy, relaying claim", "err", err)
+		check = txs.ClaimCheck{Submit: true}
+	}
+	if check.Mismatch {
+		w.metrics.IncClaimMismatches(w.Name())
+		Alert(logger, "Claim differs from the prophecy's leading claim",
+			"cosmos_receiver", claim.CosmosReceiver, "invalid_receiver", claim.InvalidReceiver, "amount", claim.Amount,
+			"leading_cosmos_receiver", check.LeadingClaim.CosmosReceiver, "leading_invalid_receiver", check.LeadingClaim.InvalidReceiver,
+			"leading_amount", check.LeadingClaim.Amount,
+			"leading_validators", check.LeadingValidators)
+	}
+	if !check.Submit {
+		w.metrics.IncClaimsSkipped(w.Name())
+		events.NewRelayOutcomeWrite(prophecyID, events.RelayOutcome{
+			Status: string(txs.OutcomeAlreadyProcessed),
+			Err:    check.Reason,
+		})
+		logger.Info("Skipping claim", "reason", check.Reason)
+		return nil
+	}
+
+	// Queue the claim for relay
+	var msg sdk.Msg = ethbridge.NewMsgMakeEthBridgeClaim(claim)
+	if claim.InvalidReceiver != "" {
+		msg = ethbridge.NewMsgMakeInvalidReceiverClaim(claim)
+	}
+	err = w.worker.Enqueue(msg)
+	if err != nil {
+		w.metrics.IncRelayFailures()
+		events.NewDeadLetterWrite(txHash, vLog.Index, vLog.BlockNumber, events.LogLock, vLog.Data, err)
+		return err
+	}
+	logger.Info("Queued claim for relay")
+	if w.cfg.Verify {
+		w.queued[prophecyID] = time.Now()
+	}
+
+	return nil
+}
+
+// verifyPending retries the locks which could not be verified yet, then claims the pending prophecies on the
+// network which the validator has not claimed, once their lock is verified against the contract. An alert is
+// raised once for each prophecy whose lock fails verification.
+func (w *Watcher) verifyPending(ctx context.Context, backend VerifierBackend) {
+	for key, lock := range w.unverified {
+		delete(w.unverified, key)
+		logger := w.logger.With("tx_hash", lock.vLog.TxHash.Hex(), "block", lock.vLog.BlockNumber,
+			"log_index", lock.vLog.Index, "event", events.LogLock, "nonce", lock.event.Nonce)
+		if err := w.handleLockEvent(ctx, backend, logger, lock.vLog, lock.event); err != nil {
+			logger.Error("Error processing event", "err", err)
+		}
+	}
+
+	prophecies, err := w.querier.QueryPendingProphecies(w.cfg.ChainID)
+	if err != nil {
+		w.logger.Error("Error querying pending prophecies", "err", err)
+		return
+	}
+
+	pending := make(map[string]bool)
+	for _, prophecy := range prophecies {
+		// Prophecies on locks made on another contract of the same chain are left to that contract's watcher
+		if prophecy.ID.BridgeContractAddress != w.contractAddress.Hex() {
+			continue
+		}
+		id := prophecy.ID.String()
+		pending[id] = true
+		if w.rejected[id] || hasClaimed(prophecy, w.validatorAddress) {
+			continue
+		}
+		if queuedAt, ok := w.queued[id]; ok && time.Since(queuedAt) < queuedClaimTTL {
+			continue
+		}
+		w.verifyProphecy(ctx, backend, prophecy)
+	}
+
+	// Prophecies are only alerted on again if they are still pending
+	for id := range w.rejected {
+		if !pending[id] {
+			delete(w.rejected, id)
+		}
+	}
+	for id, queuedAt := range w.queued {
+		if time.Since(queuedAt) >= queuedClaimTTL {
+			delete(w.queued, id)
+		}
+	}
+}
+
+// verifyProphecy verifies the lock claimed by a pending prophecy against each transaction named by its claims,
+// and queues the validator's own claim on the first lock which is verified
+func (w *Watcher) verifyProphecy(ctx context.Context, backend VerifierBackend, prophecy ethbridgeTypes.QueryEthProphecyResponse) {
+	logger := w.logger.With("prophecy_id", prophecy.ID.String())
+	if len(prophecy.EthBridgeClaims) == 0 {
+		return
+	}
+	nonce := ethbridgeTypes.NonceBigInt(prophecy.EthBridgeClaims[0].Nonce)
+	sender := common.HexToAddress(prophecy.EthBridgeClaims[0].EthereumSender)
+
+	verifyErr := errVerification("no claim names the transaction which made the lock")
+	for _, txHash := range claimedTxHashes(prophecy.EthBridgeClaims) {
+		event, vLog, err := w.verifier.VerifyLock(ctx, backend, txHash, nonce, sender)
+		if err == nil && common.Hash(event.Id).Hex() != prophecy.ID.ItemID {
+			err = errVerification("lock has item id %s, not the prophecy's item id", common.Hash(event.Id).Hex())
+		}
+		if err == nil {
+			logger.Info("Verified lock of pending prophecy", "tx_hash", txHash.Hex())
+			relayLogger := w.logger.With("tx_hash", txHash.Hex(), "block", vLog.BlockNumber, "log_index", vLog.Index,
+				"event", events.LogLock, "nonce", event.Nonce, "sender", event.From.Hex())
+			if err := w.relayLockClaim(relayLogger, vLog, event); err != nil {
+				relayLogger.Error("Error processing event", "err", err)
+			}
+			return
+		}
+		if !IsVerificationError(err) {
+			logger.Error("Could not verify lock of pending prophecy, retrying later", "tx_hash", txHash.Hex(), "err", err)
+			return
+		}
+		verifyErr = err
+	}
+
+	w.rejected[prophecy.ID.String()] = true
+	w.metrics.IncVerificationFailures(w.Name())
+	Alert(logger, "Pending prophecy failed verification, not claiming it", "err", verifyErr,
+		"claims", len(prophecy.EthBridgeClaims))
+}
+
+// hasClaimed returns true if the validator has made a claim on the prophecy
+func hasClaimed(prophecy ethbridgeTypes.QueryEthProphecyResponse, validator sdk.AccAddress) bool {
+	for _, claim := range prophecy.EthBridgeClaims {
+		if claim.Validator.Equals(validator) {
+			return true
+		}
+	}
+	return false
+}
+
+// claimedTxHashes returns the distinct transaction hashes named by the claims, in the order they are first named
+func claimedTxHashes(claims []ethbridgeTypes.EthBridgeClaim) []common.Hash {
+	var txHashes []common.Hash
+	seen := make(map[common.Hash]bool)
+	for _, claim := range claims {
+		if claim.EthereumProvenance.TxHash == "" {
+			continue
+		}
+		txHash := common.HexToHash(claim.EthereumProvenance.TxHash)
+		if !seen[txHash] {
+			seen[txHash] = true
+			txHashes = append(txHashes, txHash)
+		}
+	}
+	return txHashes
+}
+
+// handleRevocationEvent queues a revocation of a lock which has been withdrawn or unlocked on Ethereum,
+// so that it is no longer backed by coins on Cosmos
+func (w *Watcher) handleRevocationEvent(logger log.Logger, vLog types.Log, event events.PeggyEvent) error {
+	txHash := vLog.TxHash.Hex()
+	eventName := event.EventName()
+
+	var revocation ethbridgeTypes.EthBridgeRevocation
+	var err error
+	switch event := event.(type) {
+	case events.WithdrawEvent:
+		revocation, err = txs.ParseRevocationPayload(w.cfg.ChainID, w.contractAddress, w.validatorAddress, event.Id, event.Nonce, event.To, ethbridgeTypes.RevocationReasonWithdraw)
+	case events.UnlockEvent:
+		revocation, err = txs.ParseRevocationPayload(w.cfg.ChainID, w.contractAddress, w.validatorAddress, event.Id, event.Nonce, event.To, ethbridgeTypes.RevocationReasonUnlock)
+	default:
+		err = events.ErrUnsupportedEvent(eventName)
+	}
+	if err != nil {
+		w.metrics.IncParseFailures(w.Name())
+		events.NewDeadLetterWrite(txHash, vLog.Index, vLog.BlockNumber, eventName, vLog.Data, err)
+		return err
+	}
+	w.metrics.IncEventsParsed(w.Name())
+
+	msg := ethbridge.NewMsgRevokeEthBridgeClaim(revocation)
+	err = w.worker.Enqueue(msg)
+	if err != nil {
+		w.metrics.IncRelayFailures()
+		events.NewDeadLetterWrite(txHash, vLog.Index, vLog.BlockNumber, eventName, vLog.Data, err)
+		return err
+	}
+	logger.Info("Queued revocation for relay", "nonce", revocation.Nonce, "prophecy_id", txs.MsgProphecyID(msg),
+		"reason", revocation.Reason)
+
+	return nil
+}
+
+// handleStatusEvent records that locking on the contract was paused or activated, alerts the operator,
+// and queues an attestation of the new status so that it can be queried on Cosmos
+func (w *Watcher) handleStatusEvent(logger log.Logger, vLog types.Log, event events.PeggyEvent) error {
+	txHash := vLog.TxHash.Hex()
+	active := event.EventName() == events.LogLockingActivated
+
+	w.status.Update(active, vLog.BlockNumber)
+	if active {
+		Alert(logger, "Locking on the bridge contract was activated")
+	} else {
+		Alert(logger, "Locking on the bridge contract was paused")
+	}
+	w.metrics.IncEventsParsed(w.Name())
+
+	statusClaim := ethbridgeTypes.NewBridgeStatusClaim(w.cfg.ChainID, active, vLog.BlockNumber, w.validatorAddress)
+	msg := ethbridge.NewMsgMakeBridgeStatusClaim(statusClaim)
+	err := w.worker.Enqueue(msg)
+	if err != nil {
+		w.metrics.IncRelayFailures()
+		events.NewDeadLetterWrite(txHash, vLog.Index, vLog.BlockNumber, event.EventName(), vLog.Data, err)
+		return err
+	}
+	logger.Info("Queued bridge status claim for relay", "prophecy_id", txs.MsgProphecyID(msg))
+
+	return nil
+}
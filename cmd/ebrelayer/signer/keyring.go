@@ -0,0 +1,78 @@
+package signer
+
+// ------------------------------------------------------------
+//    Keyring
+//
+//    Unlocks the validator's key from the keybase once, so
+//    that its passphrase does not have to be kept around.
+// ------------------------------------------------------------
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	clientkeys "github.com/cosmos/cosmos-sdk/client/keys"
+	"github.com/cosmos/cosmos-sdk/crypto/keys"
+)
+
+// NewKeyringSigner unlocks the named key in the keybase and returns a signer holding the decrypted key.
+// The passphrase is zeroed before returning, whether or not the key could be unlocked.
+func NewKeyringSigner(kb keys.Keybase, name string, passphrase []byte) (PrivKeySigner, error) {
+	defer Zero(passphrase)
+
+	privKey, err := kb.ExportPrivateKeyObject(name, string(passphrase))
+	if err != nil {
+		return PrivKeySigner{}, fmt.Errorf("failed to unlock key %s: %v", name, err)
+	}
+	return NewPrivKeySigner(privKey), nil
+}
+
+// Zero overwrites a secret so that it does not linger in memory
+func Zero(secret []byte) {
+	for i := range secret {
+		secret[i] = 0
+	}
+}
+
+// PassphraseFromFile reads a passphrase from a file, ignoring a trailing newline. The file must not be
+// accessible by other users, as with a systemd credential or a secret mounted into a container.
+func PassphraseFromFile(path string) ([]byte, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if info.Mode().Perm()&0077 != 0 {
+		return nil, fmt.Errorf("passphrase file %s must not be accessible by group or others, has mode %v",
+			path, info.Mode().Perm())
+	}
+
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return bytes.TrimRight(contents, "\r\n"), nil
+}
+
+// PassphraseFromEnv reads a passphrase from an environment variable, which is then unset so that it is
+// not inherited by child processes
+func PassphraseFromEnv(name string) ([]byte, error) {
+	value, ok := os.LookupEnv(name)
+	if !ok || value == "" {
+		return nil, fmt.Errorf("passphrase environment variable %s is not set", name)
+	}
+	if err := os.Unsetenv(name); err != nil {
+		return nil, err
+	}
+	return []byte(value), nil
+}
+
+// PassphraseFromPrompt prompts for the passphrase of the named key on the terminal
+func PassphraseFromPrompt(name string) ([]byte, error) {
+	passphrase, err := clientkeys.GetPassphrase(name)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(passphrase), nil
+}
@@ -0,0 +1,74 @@
+package signer
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	TestKeyName    = "validator"
+	TestPassphrase = "12345678"
+)
+
+func TestKeyringSigner(t *testing.T) {
+	kb := keys.NewInMemory()
+	info, _, err := kb.CreateMnemonic(TestKeyName, keys.English, TestPassphrase, keys.Secp256k1)
+	require.NoError(t, err)
+
+	// A wrong passphrase does not unlock the key, and is still zeroed
+	wrong := []byte("wrong passphrase")
+	_, err = NewKeyringSigner(kb, TestKeyName, wrong)
+	require.Error(t, err)
+	require.Equal(t, make([]byte, len(wrong)), wrong)
+
+	passphrase := []byte(TestPassphrase)
+	signer, err := NewKeyringSigner(kb, TestKeyName, passphrase)
+	require.NoError(t, err)
+	require.Equal(t, make([]byte, len(passphrase)), passphrase)
+
+	require.Equal(t, info.GetAddress(), Address(signer))
+	sig, err := signer.Sign([]byte("sign bytes"))
+	require.NoError(t, err)
+	require.True(t, info.GetPubKey().VerifyBytes([]byte("sign bytes"), sig))
+}
+
+func TestPassphraseFromFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ebrelayer")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "passphrase")
+	require.NoError(t, ioutil.WriteFile(path, []byte(TestPassphrase+"\n"), 0600))
+
+	passphrase, err := PassphraseFromFile(path)
+	require.NoError(t, err)
+	require.Equal(t, TestPassphrase, string(passphrase))
+
+	// Files readable by other users are rejected
+	require.NoError(t, os.Chmod(path, 0644))
+	_, err = PassphraseFromFile(path)
+	require.Error(t, err)
+
+	_, err = PassphraseFromFile(filepath.Join(dir, "missing"))
+	require.Error(t, err)
+}
+
+func TestPassphraseFromEnv(t *testing.T) {
+	const name = "EBRELAYER_TEST_PASSPHRASE"
+	require.NoError(t, os.Setenv(name, TestPassphrase))
+
+	passphrase, err := PassphraseFromEnv(name)
+	require.NoError(t, err)
+	require.Equal(t, TestPassphrase, string(passphrase))
+
+	// The variable is unset once read
+	_, ok := os.LookupEnv(name)
+	require.False(t, ok)
+	_, err = PassphraseFromEnv(name)
+	require.Error(t, err)
+}
@@ -0,0 +1,174 @@
+package signer
+
+// ------------------------------------------------------------
+//    Remote
+//
+//    Signs through a separate signer process listening on a
+//    local unix socket, so that the validator's key never
+//    enters the relayer's memory.
+//
+//    Each request and response is a single line of JSON.
+// ------------------------------------------------------------
+
+import (
+	"bufio"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/tendermint/tendermint/crypto"
+)
+
+const (
+	// DefaultRemoteTimeout bounds each request to a remote signer, including connecting to it
+	DefaultRemoteTimeout = 10 * time.Second
+
+	// RequestPubKey asks a remote signer for the public key of the key it signs with
+	RequestPubKey = "pub_key"
+	// RequestSign asks a remote signer to sign the request's sign bytes
+	RequestSign = "sign"
+)
+
+// RemoteRequest is a request sent to a remote signer
+type RemoteRequest struct {
+	Type      string `json:"type"`
+	SignBytes []byte `json:"sign_bytes,omitempty"`
+}
+
+// RemoteResponse is a remote signer's response to a request
+type RemoteResponse struct {
+	PubKey    crypto.PubKey `json:"pub_key,omitempty"`
+	Signature []byte        `json:"signature,omitempty"`
+	Error     string        `json:"error,omitempty"`
+}
+
+// RemoteSigner signs through a remote signer listening on a unix socket
+type RemoteSigner struct {
+	socket  string
+	timeout time.Duration
+	cdc     *codec.Codec
+	pubKey  crypto.PubKey
+}
+
+// NewRemoteSigner connects to the remote signer on the socket to fetch the public key it signs with
+func NewRemoteSigner(socket string, timeout time.Duration) (*RemoteSigner, error) {
+	s := &RemoteSigner{
+		socket:  socket,
+		timeout: timeout,
+		cdc:     newCodec(),
+	}
+
+	res, err := s.request(RemoteRequest{Type: RequestPubKey})
+	if err != nil {
+		return nil, err
+	}
+	if res.PubKey == nil {
+		return nil, fmt.Errorf("remote signer on %s did not return a public key", socket)
+	}
+	s.pubKey = res.PubKey
+	return s, nil
+}
+
+// PubKey returns the public key of the remote signer's key
+func (s *RemoteSigner) PubKey() crypto.PubKey {
+	return s.pubKey
+}
+
+// Sign asks the remote signer to sign the bytes, rejecting signatures which do not verify against its key
+func (s *RemoteSigner) Sign(msg []byte) ([]byte, error) {
+	res, err := s.request(RemoteRequest{Type: RequestSign, SignBytes: msg})
+	if err != nil {
+		return nil, err
+	}
+	if !s.pubKey.VerifyBytes(msg, res.Signature) {
+		return nil, fmt.Errorf("remote signer on %s returned an invalid signature", s.socket)
+	}
+	return res.Signature, nil
+}
+
+// request sends a request over a new connection, so that the relayer recovers when the signer restarts
+func (s *RemoteSigner) request(req RemoteRequest) (RemoteResponse, error) {
+	conn, err := net.DialTimeout("unix", s.socket, s.timeout)
+	if err != nil {
+		return RemoteResponse{}, fmt.Errorf("failed to connect to remote signer: %v", err)
+	}
+	defer conn.Close()
+
+	if err := conn.SetDeadline(time.Now().Add(s.timeout)); err != nil {
+		return RemoteResponse{}, err
+	}
+
+	var res RemoteResponse
+	if err := writeLine(s.cdc, conn, req); err != nil {
+		return RemoteResponse{}, fmt.Errorf("failed to send request to remote signer: %v", err)
+	}
+	if err := readLine(s.cdc, bufio.NewReader(conn), &res); err != nil {
+		return RemoteResponse{}, fmt.Errorf("failed to read response from remote signer: %v", err)
+	}
+	if res.Error != "" {
+		return RemoteResponse{}, fmt.Errorf("remote signer: %s", res.Error)
+	}
+	return res, nil
+}
+
+// ServeSigner answers remote signer requests on the listener with the given signer until the listener
+// is closed. It is a minimal stand-in for a remote signer, for tests and local development.
+func ServeSigner(listener net.Listener, signer Signer) error {
+	cdc := newCodec()
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			return err
+		}
+		go serveConn(cdc, conn, signer)
+	}
+}
+
+func serveConn(cdc *codec.Codec, conn net.Conn, signer Signer) {
+	defer conn.Close()
+
+	var req RemoteRequest
+	var res RemoteResponse
+	if err := readLine(cdc, bufio.NewReader(conn), &req); err != nil {
+		res.Error = err.Error()
+	} else {
+		switch req.Type {
+		case RequestPubKey:
+			res.PubKey = signer.PubKey()
+		case RequestSign:
+			res.Signature, err = signer.Sign(req.SignBytes)
+			if err != nil {
+				res.Error = err.Error()
+			}
+		default:
+			res.Error = fmt.Sprintf("unknown request type %s", req.Type)
+		}
+	}
+
+	// The client sees a closed connection if the response cannot be written
+	_ = writeLine(cdc, conn, res)
+}
+
+func writeLine(cdc *codec.Codec, conn net.Conn, v interface{}) error {
+	bz, err := cdc.MarshalJSON(v)
+	if err != nil {
+		return err
+	}
+	_, err = conn.Write(append(bz, '\n'))
+	return err
+}
+
+func readLine(cdc *codec.Codec, reader *bufio.Reader, v interface{}) error {
+	line, err := reader.ReadBytes('\n')
+	if err != nil {
+		return err
+	}
+	return cdc.UnmarshalJSON(line, v)
+}
+
+func newCodec() *codec.Codec {
+	cdc := codec.New()
+	codec.RegisterCrypto(cdc)
+	return cdc
+}
@@ -0,0 +1,71 @@
+package signer
+
+import (
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+)
+
+// badSigner signs with a different key than the public key it reports
+type badSigner struct {
+	PrivKeySigner
+	other crypto.PrivKey
+}
+
+func (s badSigner) Sign(msg []byte) ([]byte, error) {
+	return s.other.Sign(msg)
+}
+
+func startTestSigner(t *testing.T, signer Signer) (string, func()) {
+	dir, err := ioutil.TempDir("", "ebrelayer")
+	require.NoError(t, err)
+
+	socket := filepath.Join(dir, "signer.sock")
+	listener, err := net.Listen("unix", socket)
+	require.NoError(t, err)
+	go ServeSigner(listener, signer)
+
+	return socket, func() {
+		listener.Close()
+		os.RemoveAll(dir)
+	}
+}
+
+func TestRemoteSigner(t *testing.T) {
+	local := NewPrivKeySigner(secp256k1.GenPrivKey())
+	socket, stop := startTestSigner(t, local)
+	defer stop()
+
+	remote, err := NewRemoteSigner(socket, time.Second)
+	require.NoError(t, err)
+	require.Equal(t, local.PubKey(), remote.PubKey())
+	require.Equal(t, Address(local), Address(remote))
+
+	sig, err := remote.Sign([]byte("sign bytes"))
+	require.NoError(t, err)
+	require.True(t, local.PubKey().VerifyBytes([]byte("sign bytes"), sig))
+}
+
+func TestRemoteSignerInvalidSignature(t *testing.T) {
+	bad := badSigner{NewPrivKeySigner(secp256k1.GenPrivKey()), secp256k1.GenPrivKey()}
+	socket, stop := startTestSigner(t, bad)
+	defer stop()
+
+	remote, err := NewRemoteSigner(socket, time.Second)
+	require.NoError(t, err)
+
+	_, err = remote.Sign([]byte("sign bytes"))
+	require.Error(t, err)
+}
+
+func TestRemoteSignerUnavailable(t *testing.T) {
+	_, err := NewRemoteSigner(filepath.Join(os.TempDir(), "ebrelayer-missing.sock"), time.Second)
+	require.Error(t, err)
+}
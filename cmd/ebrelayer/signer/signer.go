@@ -0,0 +1,53 @@
+package signer
+
+// ------------------------------------------------------------
+//    Signer
+//
+//    Signs the relayer's transactions with the validator's
+//    key, either unlocked once from the keybase or held by a
+//    remote signer process.
+// ------------------------------------------------------------
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto"
+)
+
+// Signer signs bytes on behalf of the relaying validator
+type Signer interface {
+	// PubKey returns the public key which verifies the signer's signatures
+	PubKey() crypto.PubKey
+	// Sign signs the bytes with the validator's key
+	Sign(msg []byte) ([]byte, error)
+}
+
+// Address returns the account address of the signer's key
+func Address(signer Signer) sdk.AccAddress {
+	return sdk.AccAddress(signer.PubKey().Address())
+}
+
+// PrivKeySigner signs with a private key held in memory
+type PrivKeySigner struct {
+	privKey crypto.PrivKey
+}
+
+// NewPrivKeySigner returns a signer for the given private key
+func NewPrivKeySigner(privKey crypto.PrivKey) PrivKeySigner {
+	return PrivKeySigner{privKey: privKey}
+}
+
+// PubKey returns the public key of the signer's private key
+func (s PrivKeySigner) PubKey() crypto.PubKey {
+	return s.privKey.PubKey()
+}
+
+// Sign signs the bytes with the signer's private key
+func (s PrivKeySigner) Sign(msg []byte) ([]byte, error) {
+	sig, err := s.privKey.Sign(msg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign: %v", err)
+	}
+	return sig, nil
+}
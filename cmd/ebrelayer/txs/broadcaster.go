@@ -0,0 +1,126 @@
+package txs
+
+// --------------------------------------------------------
+//      Broadcaster
+//
+//      Signs and broadcasts transactions containing one or
+//      more msgs on behalf of the relaying validator.
+// --------------------------------------------------------
+
+import (
+	"encoding/hex"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/utils"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authtxb "github.com/cosmos/cosmos-sdk/x/auth/client/txbuilder"
+	amino "github.com/tendermint/go-amino"
+
+	"github.com/swishlabsco/cosmos-ethereum-bridge/cmd/ebrelayer/signer"
+)
+
+// Broadcaster signs and broadcasts msgs with an explicit account number and sequence
+type Broadcaster interface {
+	// AccountInfo queries the signing account's current account number and sequence
+	AccountInfo() (accountNumber uint64, sequence uint64, err error)
+	// Broadcast signs the msgs into a single transaction, scaling its gas limit by gasAdjustment,
+	// and broadcasts it, returning once the transaction has passed or failed CheckTx
+	Broadcast(msgs []sdk.Msg, accountNumber uint64, sequence uint64, gasAdjustment float64) (sdk.TxResponse, error)
+	// QueryTx returns the result of a transaction which has been included in a block
+	QueryTx(txHash string) (sdk.TxResponse, error)
+}
+
+// CLIBroadcaster is a Broadcaster which signs with the validator's signer and broadcasts to a Tendermint node
+type CLIBroadcaster struct {
+	cliCtx context.CLIContext
+	txBldr authtxb.TxBuilder
+	signer signer.Signer
+}
+
+// NewCLIBroadcaster builds the CLI context and transaction builder once, to be reused for every broadcast
+func NewCLIBroadcaster(chainId string, cdc *amino.Codec, txSigner signer.Signer) CLIBroadcaster {
+	cliCtx := context.NewCLIContext().
+		WithCodec(cdc).
+		WithAccountDecoder(cdc).
+		WithFromAddress(signer.Address(txSigner))
+
+	cliCtx.SkipConfirm = true
+
+	txBldr := authtxb.NewTxBuilderFromCLI().
+		WithTxEncoder(utils.GetTxEncoder(cdc)).
+		WithChainID(chainId)
+
+	return CLIBroadcaster{
+		cliCtx: cliCtx,
+		txBldr: txBldr,
+		signer: txSigner,
+	}
+}
+
+// AccountInfo queries the validator's account from the node
+func (b CLIBroadcaster) AccountInfo() (uint64, uint64, error) {
+	account, err := b.cliCtx.GetAccount(b.cliCtx.GetFromAddress())
+	if err != nil {
+		return 0, 0, err
+	}
+	return account.GetAccountNumber(), account.GetSequence(), nil
+}
+
+// Broadcast signs the msgs with the given account number and sequence and broadcasts them synchronously,
+// returning once the transaction has passed or failed CheckTx
+func (b CLIBroadcaster) Broadcast(msgs []sdk.Msg, accountNumber uint64, sequence uint64, gasAdjustment float64) (sdk.TxResponse, error) {
+	gas := float64(b.txBldr.Gas()*uint64(len(msgs))) * gasAdjustment
+
+	txBldr := b.txBldr.
+		WithAccountNumber(accountNumber).
+		WithSequence(sequence).
+		WithGas(uint64(gas))
+
+	signMsg, err := txBldr.BuildSignMsg(msgs)
+	if err != nil {
+		return sdk.TxResponse{}, err
+	}
+
+	sig, err := b.signer.Sign(signMsg.Bytes())
+	if err != nil {
+		return sdk.TxResponse{}, err
+	}
+
+	stdSig := auth.StdSignature{PubKey: b.signer.PubKey(), Signature: sig}
+	tx := auth.NewStdTx(signMsg.Msgs, signMsg.Fee, []auth.StdSignature{stdSig}, signMsg.Memo)
+	txBytes, err := txBldr.TxEncoder()(tx)
+	if err != nil {
+		return sdk.TxResponse{}, err
+	}
+
+	return b.cliCtx.BroadcastTxSync(txBytes)
+}
+
+// QueryTx queries the node for a transaction by its hex encoded hash
+func (b CLIBroadcaster) QueryTx(txHash string) (sdk.TxResponse, error) {
+	hash, err := hex.DecodeString(txHash)
+	if err != nil {
+		return sdk.TxResponse{}, err
+	}
+
+	node, err := b.cliCtx.GetNode()
+	if err != nil {
+		return sdk.TxResponse{}, err
+	}
+
+	resTx, err := node.Tx(hash, !b.cliCtx.TrustNode)
+	if err != nil {
+		return sdk.TxResponse{}, err
+	}
+
+	return sdk.TxResponse{
+		Height:    resTx.Height,
+		TxHash:    txHash,
+		Code:      resTx.TxResult.Code,
+		Codespace: resTx.TxResult.Codespace,
+		Logs:      sdk.ABCIMessageLogs{{Log: resTx.TxResult.Log}},
+		GasWanted: resTx.TxResult.GasWanted,
+		GasUsed:   resTx.TxResult.GasUsed,
+	}, nil
+}
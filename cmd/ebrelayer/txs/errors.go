@@ -0,0 +1,52 @@
+package txs
+
+// --------------------------------------------------------
+//      Errors
+//
+//      Typed errors returned when an event's payload
+//      cannot be converted into a valid claim.
+// --------------------------------------------------------
+
+import (
+	"fmt"
+)
+
+// ErrorCode classifies why an event payload could not be parsed
+type ErrorCode int
+
+const (
+	CodeInvalidNonce     ErrorCode = 1
+	CodeInvalidRecipient ErrorCode = 2
+	CodeInvalidAmount    ErrorCode = 3
+)
+
+// PayloadError is returned when an event payload cannot be parsed into an EthBridgeClaim
+type PayloadError struct {
+	Code ErrorCode
+	Err  error
+}
+
+func (e PayloadError) Error() string {
+	switch e.Code {
+	case CodeInvalidNonce:
+		return fmt.Sprintf("invalid nonce: %v", e.Err)
+	case CodeInvalidRecipient:
+		return fmt.Sprintf("invalid cosmos recipient: %v", e.Err)
+	case CodeInvalidAmount:
+		return fmt.Sprintf("invalid amount: %v", e.Err)
+	default:
+		return fmt.Sprintf("invalid payload: %v", e.Err)
+	}
+}
+
+func ErrInvalidNonce(err error) error {
+	return PayloadError{Code: CodeInvalidNonce, Err: err}
+}
+
+func ErrInvalidRecipient(err error) error {
+	return PayloadError{Code: CodeInvalidRecipient, Err: err}
+}
+
+func ErrInvalidAmount(err error) error {
+	return PayloadError{Code: CodeInvalidAmount, Err: err}
+}
@@ -10,46 +10,131 @@ package txs
 // --------------------------------------------------------
 
 import (
-  "strings"
-  "strconv"
-  "fmt"
+	"fmt"
+	"math/big"
 
-  sdk "github.com/cosmos/cosmos-sdk/types"
-  "github.com/swishlabsco/cosmos-ethereum-bridge/cmd/ebrelayer/events"
-  "github.com/swishlabsco/cosmos-ethereum-bridge/x/ethbridge/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/swishlabsco/cosmos-ethereum-bridge/cmd/ebrelayer/events"
+	"github.com/swishlabsco/cosmos-ethereum-bridge/x/ethbridge"
+	"github.com/swishlabsco/cosmos-ethereum-bridge/x/ethbridge/types"
 )
 
-func ParsePayload(validator sdk.AccAddress, event *events.LockEvent) (types.EthBridgeClaim, error) {
-  
-  witnessClaim := types.EthBridgeClaim{}
-
-  // Nonce type casting (*big.Int -> int)
-  nonce, nonceErr := strconv.Atoi(event.Nonce.String())
-  if nonceErr != nil {
-    fmt.Errorf("%s", nonceErr)
-  }
-  witnessClaim.Nonce = nonce
-
-  // EthereumSender type casting (address.common -> string)
-  witnessClaim.EthereumSender = event.From.Hex()
-
-  // CosmosReceiver type casting (bytes[] -> sdk.AccAddress)
-  recipient, recipientErr := sdk.AccAddressFromBech32(string(event.To[:]))
-  if recipientErr != nil {
-    fmt.Errorf("%s", recipientErr)
-  }
-  witnessClaim.CosmosReceiver = recipient
-
-  // Validator is already the correct type (sdk.AccAddress)
-  witnessClaim.Validator = validator
-
-  // Amount type casting (*big.Int -> sdk.Coins)
-  ethereumCoin := []string {event.Value.String(),"ethereum"}
-  weiAmount, coinErr := sdk.ParseCoins(strings.Join(ethereumCoin, ""))
-  if coinErr != nil {
-    fmt.Errorf("%s", coinErr)
-  }
-  witnessClaim.Amount = weiAmount
-
-  return witnessClaim, nil
+// maxAmountBitLen is the largest bit length of an sdk.Int
+const maxAmountBitLen = 255
+
+// BridgedDenom is the denom the value of Peggy locks is minted as
+const BridgedDenom = "ethereum"
+
+// ParsePayload converts a LockEvent decoded from the given log on the given ethereum chain into an
+// EthBridgeClaim, returning a PayloadError on failure. The claim carries the log's provenance, and is made on the
+// contract which emitted the log.
+func ParsePayload(ethereumChainID int, validator sdk.AccAddress, vLog ethtypes.Log, event *events.LockEvent) (types.EthBridgeClaim, error) {
+	witnessClaim, err := parseLockPayload(ethereumChainID, validator, vLog, event)
+	if err != nil {
+		return types.EthBridgeClaim{}, err
+	}
+
+	// CosmosReceiver type casting (bytes[] -> sdk.AccAddress)
+	recipient, recipientErr := sdk.AccAddressFromBech32(string(event.To[:]))
+	if recipientErr != nil {
+		return types.EthBridgeClaim{}, ErrInvalidRecipient(recipientErr)
+	}
+	witnessClaim.CosmosReceiver = recipient
+
+	return witnessClaim, nil
+}
+
+// ParseInvalidReceiverPayload converts a LockEvent whose recipient is not a valid cosmos address into a claim that
+// the lock was made to an invalid receiver, so that the lock is refunded on ethereum instead of minted
+func ParseInvalidReceiverPayload(ethereumChainID int, validator sdk.AccAddress, vLog ethtypes.Log, event *events.LockEvent) (types.EthBridgeClaim, error) {
+	witnessClaim, err := parseLockPayload(ethereumChainID, validator, vLog, event)
+	if err != nil {
+		return types.EthBridgeClaim{}, err
+	}
+
+	// InvalidReceiver type casting (bytes[] -> hex string), so that any recipient bytes can be claimed
+	witnessClaim.InvalidReceiver = hexutil.Encode(event.To[:])
+
+	return witnessClaim, nil
+}
+
+// parseLockPayload converts the parts of a LockEvent other than its recipient into an EthBridgeClaim
+func parseLockPayload(ethereumChainID int, validator sdk.AccAddress, vLog ethtypes.Log, event *events.LockEvent) (types.EthBridgeClaim, error) {
+	witnessClaim := types.EthBridgeClaim{}
+	witnessClaim.EthereumChainID = ethereumChainID
+	witnessClaim.BridgeContractAddress = vLog.Address.Hex()
+
+	// ItemID type casting ([32]byte -> string)
+	witnessClaim.ItemID = common.Hash(event.Id).Hex()
+
+	// Nonce type casting (*big.Int -> sdk.Uint)
+	nonce, nonceErr := types.ParseNonce(event.Nonce.String())
+	if nonceErr != nil {
+		return types.EthBridgeClaim{}, ErrInvalidNonce(nonceErr)
+	}
+	witnessClaim.Nonce = nonce
+
+	// EthereumSender type casting (address.common -> string)
+	witnessClaim.EthereumSender = event.From.Hex()
+
+	// Validator is already the correct type (sdk.AccAddress)
+	witnessClaim.Validator = validator
+
+	// Amount type casting (*big.Int -> sdk.Coins)
+	weiAmount, coinErr := parseAmount(event.Value)
+	if coinErr != nil {
+		return types.EthBridgeClaim{}, ErrInvalidAmount(coinErr)
+	}
+	witnessClaim.Amount = weiAmount
+
+	// EthereumProvenance locates the lock on ethereum, so that the claim can be audited against the chain
+	witnessClaim.EthereumProvenance = types.NewEthereumProvenance(
+		vLog.TxHash.Hex(), vLog.BlockNumber, vLog.BlockHash.Hex(), uint64(vLog.Index))
+
+	return witnessClaim, nil
+}
+
+// ParseRevocationPayload converts the id, nonce and original sender of an item released back by the bridge contract
+// on the given ethereum chain into an EthBridgeRevocation, returning a PayloadError on failure
+func ParseRevocationPayload(ethereumChainID int, bridgeContract common.Address, validator sdk.AccAddress, itemID [32]byte, nonce *big.Int, sender common.Address, reason string) (types.EthBridgeRevocation, error) {
+	// Nonce type casting (*big.Int -> sdk.Uint)
+	revocationNonce, nonceErr := types.ParseNonce(nonce.String())
+	if nonceErr != nil {
+		return types.EthBridgeRevocation{}, ErrInvalidNonce(nonceErr)
+	}
+
+	return types.NewEthBridgeRevocation(ethereumChainID, bridgeContract.Hex(), common.Hash(itemID).Hex(), revocationNonce, sender.Hex(), validator, reason), nil
+}
+
+// parseAmount converts a wei value into ethereum coins without losing precision. Peggy values are uint256, of
+// which an sdk.Int holds all but those of 2^255 and above.
+func parseAmount(value *big.Int) (sdk.Coins, error) {
+	if value.Sign() < 0 || value.BitLen() > maxAmountBitLen {
+		return nil, fmt.Errorf("%s does not fit in a coin amount", value)
+	}
+	return sdk.Coins{sdk.NewCoin(BridgedDenom, sdk.NewIntFromBigInt(new(big.Int).Set(value)))}, nil
+}
+
+// ProphecyID returns the id of the oracle prophecy which the claim is made on, in readable form
+func ProphecyID(claim types.EthBridgeClaim) string {
+	return claim.ProphecyID().String()
+}
+
+// MsgProphecyID returns the id of the oracle prophecy which a relayed ethbridge msg is made on
+func MsgProphecyID(msg sdk.Msg) string {
+	switch msg := msg.(type) {
+	case ethbridge.MsgMakeEthBridgeClaim:
+		return ProphecyID(msg.EthBridgeClaim)
+	case ethbridge.MsgMakeInvalidReceiverClaim:
+		return ProphecyID(msg.EthBridgeClaim)
+	case ethbridge.MsgRevokeEthBridgeClaim:
+		return "revoke:" + msg.EthBridgeRevocation.ProphecyID().String()
+	case ethbridge.MsgMakeBridgeStatusClaim:
+		return types.CreateBridgeStatusProphecyID(msg.EthereumChainID, msg.EthereumBlock)
+	default:
+		return ""
+	}
 }
@@ -1,68 +1,194 @@
 package txs
 
 import (
-	"testing"
 	"fmt"
 	"math/big"
+	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/stretchr/testify/require"
 	"github.com/swishlabsco/cosmos-ethereum-bridge/cmd/ebrelayer/events"
-	"github.com/ethereum/go-ethereum/common"
+	"github.com/swishlabsco/cosmos-ethereum-bridge/x/ethbridge"
+	"github.com/swishlabsco/cosmos-ethereum-bridge/x/ethbridge/types"
+)
+
+const (
+	TestRecipient       = "cosmos1gn8409qq9hnrxde37kuxwx5hrxpfpv8426szuv"
+	TestEthereumChainID = 3
+	TestBridgeContract  = "0x3de4ef81Ba6243A60B0a32d3BCeD4173b6EA02bb"
 )
 
 var TestValidator sdk.AccAddress
+var TestLog = ethtypes.Log{
+	Address:     common.HexToAddress(TestBridgeContract),
+	TxHash:      common.HexToHash("0xab85e2ceaa7d100af2f07cac01365f3777153a4e004342dca5db44e731b9d461"),
+	BlockNumber: 100,
+	BlockHash:   common.HexToHash("0x8d1c1b4fbfc1d4e4e1a0d5b15d2b1c7c2c10e6e2e1a9c46a5f9bf5f1e3c2b2a1"),
+	Index:       2,
+}
 var TestEventData events.LockEvent
 
 func init() {
 
 	// Set up testing parameters for the parser
 	testValidator, err := sdk.AccAddressFromBech32("cosmos1xdp5tvt7lxh8rf9xx07wy2xlagzhq24ha48xtq")
-  if err != nil {
-    fmt.Errorf("%s", err)
-  }
-  TestValidator = testValidator
+	if err != nil {
+		panic(err)
+	}
+	TestValidator = testValidator
 
 	// Mock expected data from the parser
-	TestEventData := events.LockEvent{}
+	TestEventData = events.LockEvent{}
 
 	var arr [32]byte
 	copy(arr[:], []byte("0xab85e2ceaa7d100af2f07cac01365f3777153a4e004342dca5db44e731b9d461"))
 	TestEventData.Id = arr
 	TestEventData.From = common.BytesToAddress([]byte("0xC8Ee928625908D90d4B60859052aD200CBe2792A"))
-	TestEventData.To = []byte("0x6e656f")
+	TestEventData.To = []byte(TestRecipient)
 	TestEventData.Token = common.BytesToAddress([]byte("0x0000000000000000000000000000000000000000"))
 
 	value := new(big.Int)
 	value, okValue := value.SetString("7", 10)
 	if !okValue {
-	  fmt.Println("SetString: error")
-  }
+		fmt.Println("SetString: error")
+	}
 	TestEventData.Value = value
 
 	nonce := new(big.Int)
 	nonce, okNonce := nonce.SetString("39", 10)
 	if !okNonce {
-	  fmt.Println("SetString: error")
-  }
+		fmt.Println("SetString: error")
+	}
 	TestEventData.Nonce = nonce
-
-	fmt.Printf("%+v", TestEventData)
-	
 }
 
 // Set up data for parameters and to compare against
 func TestParsePayload(t *testing.T) {
-	result, err := ParsePayload(TestValidator, &TestEventData)
+	result, err := ParsePayload(TestEthereumChainID, TestValidator, TestLog, &TestEventData)
+	require.NoError(t, err)
 
+	expectedRecipient, err := sdk.AccAddressFromBech32(TestRecipient)
 	require.NoError(t, err)
-	fmt.Printf("%+v", result)
 
-	// TODO: check each individual argument
-	// require.Equal(t, "7", string(result.Nonce))
-	// require.Equal(t, common.BytesToAddress([]byte("0xC8Ee928625908D90d4B60859052aD200CBe2792A")), result.EthereumSender)
-	// require.Equal(t, result.CosmosReceiver, "neo")
-	// require.Equal(t, result.Validator, TestValidator)
-	// require.Equal(t, result.Amount, 7)
+	require.Equal(t, TestEthereumChainID, result.EthereumChainID)
+	require.Equal(t, TestBridgeContract, result.BridgeContractAddress)
+	require.Equal(t, common.Hash(TestEventData.Id).Hex(), result.ItemID)
+	require.Equal(t, "39", result.Nonce.String())
+	require.Equal(t, TestEventData.From.Hex(), result.EthereumSender)
+	require.Equal(t, expectedRecipient, result.CosmosReceiver)
+	require.Equal(t, TestValidator, result.Validator)
+	require.Equal(t, "7ethereum", result.Amount.String())
+	require.Equal(t, TestLog.TxHash.Hex(), result.EthereumProvenance.TxHash)
+	require.Equal(t, uint64(100), result.EthereumProvenance.BlockNumber)
+	require.Equal(t, TestLog.BlockHash.Hex(), result.EthereumProvenance.BlockHash)
+	require.Equal(t, uint64(2), result.EthereumProvenance.LogIndex)
+	require.NoError(t, ethbridge.NewMsgMakeEthBridgeClaim(result).ValidateBasic())
+}
+
+func TestParsePayloadLargeValues(t *testing.T) {
+	// Nonces and values are uint256 on ethereum, so they must not be truncated to an int64
+	largeNonce, ok := new(big.Int).SetString("18446744073709551621", 10)
+	require.True(t, ok)
+	largeValue := new(big.Int).Lsh(big.NewInt(1), 200)
+
+	largeEvent := TestEventData
+	largeEvent.Nonce = largeNonce
+	largeEvent.Value = largeValue
+
+	result, err := ParsePayload(TestEthereumChainID, TestValidator, TestLog, &largeEvent)
+	require.NoError(t, err)
+	require.Equal(t, "18446744073709551621", result.Nonce.String())
+	require.Equal(t, largeValue, result.Amount.AmountOf("ethereum").BigInt())
+	require.NoError(t, ethbridge.NewMsgMakeEthBridgeClaim(result).ValidateBasic())
+
+	// The nonce it would have been truncated to is a different nonce
+	truncatedEvent := TestEventData
+	truncatedEvent.Nonce = big.NewInt(5)
+	truncated, err := ParsePayload(TestEthereumChainID, TestValidator, TestLog, &truncatedEvent)
+	require.NoError(t, err)
+	require.False(t, truncated.Nonce.Equal(result.Nonce))
+
+	// Values of 2^255 and above do not fit in a coin amount
+	largeEvent.Value = new(big.Int).Lsh(big.NewInt(1), 255)
+	_, err = ParsePayload(TestEthereumChainID, TestValidator, TestLog, &largeEvent)
+	require.Error(t, err)
+	payloadErr, ok := err.(PayloadError)
+	require.True(t, ok)
+	require.Equal(t, CodeInvalidAmount, payloadErr.Code)
+
+	revocation, err := ParseRevocationPayload(TestEthereumChainID, TestLog.Address, TestValidator, TestEventData.Id, largeNonce, TestEventData.From, types.RevocationReasonWithdraw)
+	require.NoError(t, err)
+	require.Equal(t, "18446744073709551621", revocation.Nonce.String())
+}
+
+func TestParsePayloadInvalidRecipient(t *testing.T) {
+	badEvent := TestEventData
+	badEvent.To = []byte("0x6e656f")
 
-}
\ No newline at end of file
+	_, err := ParsePayload(TestEthereumChainID, TestValidator, TestLog, &badEvent)
+	require.Error(t, err)
+
+	payloadErr, ok := err.(PayloadError)
+	require.True(t, ok)
+	require.Equal(t, CodeInvalidRecipient, payloadErr.Code)
+}
+
+func TestParseInvalidReceiverPayload(t *testing.T) {
+	badEvent := TestEventData
+	badEvent.To = []byte("0x6e656f")
+
+	result, err := ParseInvalidReceiverPayload(TestEthereumChainID, TestValidator, TestLog, &badEvent)
+	require.NoError(t, err)
+
+	require.Equal(t, "0x3078366536353666", result.InvalidReceiver)
+	require.True(t, result.CosmosReceiver.Empty())
+	require.Equal(t, TestEventData.From.Hex(), result.EthereumSender)
+	require.Equal(t, "7ethereum", result.Amount.String())
+	require.NoError(t, ethbridge.NewMsgMakeInvalidReceiverClaim(result).ValidateBasic())
+	require.Equal(t, ProphecyID(result), MsgProphecyID(ethbridge.NewMsgMakeInvalidReceiverClaim(result)))
+}
+
+func TestParseRevocationPayload(t *testing.T) {
+	revocation, err := ParseRevocationPayload(TestEthereumChainID, TestLog.Address, TestValidator, TestEventData.Id, TestEventData.Nonce, TestEventData.From, types.RevocationReasonUnlock)
+	require.NoError(t, err)
+
+	require.Equal(t, TestEthereumChainID, revocation.EthereumChainID)
+	require.Equal(t, TestBridgeContract, revocation.BridgeContractAddress)
+	require.Equal(t, common.Hash(TestEventData.Id).Hex(), revocation.ItemID)
+	require.Equal(t, "39", revocation.Nonce.String())
+	require.Equal(t, TestEventData.From.Hex(), revocation.EthereumSender)
+	require.Equal(t, TestValidator, revocation.Validator)
+	require.Equal(t, types.RevocationReasonUnlock, revocation.Reason)
+
+	// The revocation is made on its own prophecy, derived from the id of the lock it revokes
+	claim, err := ParsePayload(TestEthereumChainID, TestValidator, TestLog, &TestEventData)
+	require.NoError(t, err)
+	claimID := MsgProphecyID(ethbridge.NewMsgMakeEthBridgeClaim(claim))
+	require.Equal(t, ProphecyID(claim), claimID)
+	require.Equal(t, "3:"+TestBridgeContract+":"+common.Hash(TestEventData.Id).Hex(), claimID)
+	require.Equal(t, "revoke:"+claimID, MsgProphecyID(ethbridge.NewMsgRevokeEthBridgeClaim(revocation)))
+
+	statusClaim := types.NewBridgeStatusClaim(TestEthereumChainID, false, 100, TestValidator)
+	require.Equal(t, types.CreateBridgeStatusProphecyID(TestEthereumChainID, 100), MsgProphecyID(ethbridge.NewMsgMakeBridgeStatusClaim(statusClaim)))
+
+	// The same lock on another ethereum chain is made on a different prophecy
+	otherClaim, err := ParsePayload(TestEthereumChainID+1, TestValidator, TestLog, &TestEventData)
+	require.NoError(t, err)
+	require.NotEqual(t, claimID, ProphecyID(otherClaim))
+
+	// As is the same item on another bridge contract
+	otherLog := TestLog
+	otherLog.Address = common.HexToAddress("0x7B95B6EC7EbD73572298cEf32Bb54FA408207359")
+	otherContractClaim, err := ParsePayload(TestEthereumChainID, TestValidator, otherLog, &TestEventData)
+	require.NoError(t, err)
+	require.NotEqual(t, claimID, ProphecyID(otherContractClaim))
+
+	// And another item with the same nonce
+	otherItemEvent := TestEventData
+	otherItemEvent.Id[0]++
+	otherItemClaim, err := ParsePayload(TestEthereumChainID, TestValidator, TestLog, &otherItemEvent)
+	require.NoError(t, err)
+	require.NotEqual(t, claimID, ProphecyID(otherItemClaim))
+}
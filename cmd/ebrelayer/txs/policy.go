@@ -0,0 +1,109 @@
+package txs
+
+// --------------------------------------------------------
+//      Policy
+//
+//      Classifies broadcast results by ABCI code into
+//      terminal and retryable outcomes, and defines how
+//      retryable claims are retried.
+// --------------------------------------------------------
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ethbridgetypes "github.com/swishlabsco/cosmos-ethereum-bridge/x/ethbridge/types"
+	oracletypes "github.com/swishlabsco/cosmos-ethereum-bridge/x/oracle/types"
+)
+
+// Outcome is the state of a relayed claim
+type Outcome string
+
+const (
+	// OutcomeCommitted means the claim was included in a block and accepted
+	OutcomeCommitted Outcome = "committed"
+	// OutcomeAlreadyProcessed means the chain already holds this validator's claim or a finalized prophecy
+	OutcomeAlreadyProcessed Outcome = "already_processed"
+	// OutcomeRejected means the claim was rejected and will never succeed if resent
+	OutcomeRejected Outcome = "rejected"
+	// OutcomeRetryable means the claim failed for a reason which may be resolved by resending it
+	OutcomeRetryable Outcome = "retryable"
+	// OutcomeFailed means the claim was still failing after all retries were exhausted
+	OutcomeFailed Outcome = "failed"
+)
+
+// IsTerminal returns true if the claim should not be sent again
+func (o Outcome) IsTerminal() bool {
+	return o != OutcomeRetryable
+}
+
+// ClassifyResponse maps an ABCI codespace and code to the outcome of a relayed claim
+func ClassifyResponse(codespace string, code uint32) Outcome {
+	if code == uint32(sdk.CodeOK) {
+		return OutcomeCommitted
+	}
+
+	switch sdk.CodespaceType(codespace) {
+	case oracletypes.DefaultCodespace:
+		switch sdk.CodeType(code) {
+		case oracletypes.CodeProphecyFinalized, oracletypes.CodeDuplicateMessage:
+			return OutcomeAlreadyProcessed
+		case oracletypes.CodeInternalDB:
+			return OutcomeRetryable
+		default:
+			return OutcomeRejected
+		}
+	case ethbridgetypes.DefaultCodespace:
+		return OutcomeRejected
+	case sdk.CodespaceRoot, "":
+		switch sdk.CodeType(code) {
+		case sdk.CodeInternal, sdk.CodeInvalidSequence, sdk.CodeOutOfGas:
+			return OutcomeRetryable
+		default:
+			return OutcomeRejected
+		}
+	default:
+		return OutcomeRejected
+	}
+}
+
+// RetryPolicy controls how long a relayed claim is waited on and how often it is resent
+type RetryPolicy struct {
+	// MaxAttempts is the number of times a claim is broadcast before giving up
+	MaxAttempts int
+	// InitialBackoff is the delay before the first retry, doubling on each later attempt
+	InitialBackoff time.Duration
+	// MaxBackoff caps the delay between retries
+	MaxBackoff time.Duration
+	// ConfirmTimeout is how long to poll for a broadcast tx to be included in a block
+	ConfirmTimeout time.Duration
+	// PollInterval is the delay between inclusion queries
+	PollInterval time.Duration
+	// GasAdjustment multiplies the gas limit each time a claim runs out of gas
+	GasAdjustment float64
+}
+
+// DefaultRetryPolicy returns the retry policy used by the relayer
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:    5,
+		InitialBackoff: 2 * time.Second,
+		MaxBackoff:     time.Minute,
+		ConfirmTimeout: 30 * time.Second,
+		PollInterval:   time.Second,
+		GasAdjustment:  1.5,
+	}
+}
+
+// Backoff returns the delay before the given retry attempt, starting from 1
+func (p RetryPolicy) Backoff(attempt int) time.Duration {
+	backoff := p.InitialBackoff
+	for i := 1; i < attempt; i++ {
+		backoff *= 2
+		if backoff >= p.MaxBackoff {
+			return p.MaxBackoff
+		}
+	}
+	return backoff
+}
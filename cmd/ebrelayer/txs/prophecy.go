@@ -0,0 +1,171 @@
+package txs
+
+// --------------------------------------------------------
+//      Prophecy
+//
+//      Checks a claim against the current state of its
+//      prophecy on the Cosmos bridge before it is relayed,
+//      so that claims which cannot change the prophecy are
+//      not paid for.
+// --------------------------------------------------------
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/swishlabsco/cosmos-ethereum-bridge/x/ethbridge"
+	"github.com/swishlabsco/cosmos-ethereum-bridge/x/ethbridge/types"
+	oracletypes "github.com/swishlabsco/cosmos-ethereum-bridge/x/oracle/types"
+)
+
+// ProphecyQuerier queries the state of prophecies on the Cosmos bridge
+type ProphecyQuerier interface {
+	// QueryEthProphecy returns the prophecy for a lock, or false if no claim has been made on it yet
+	QueryEthProphecy(params types.QueryEthProphecyParams) (types.QueryEthProphecyResponse, bool, error)
+	// QueryPendingProphecies returns the pending prophecies on locks made on an ethereum chain
+	QueryPendingProphecies(ethereumChainID int) ([]types.QueryEthProphecyResponse, error)
+}
+
+// ClaimCheck is the result of checking a claim against its prophecy
+type ClaimCheck struct {
+	// Submit is false when the claim cannot change the prophecy, with the reason in Reason
+	Submit bool
+	Reason string
+
+	// Mismatch is true when the claim differs from the prophecy's leading or final claim, made by LeadingValidators
+	Mismatch          bool
+	LeadingClaim      types.OracleClaim
+	LeadingValidators int
+}
+
+// CheckClaim queries the claim's prophecy and decides whether the claim should be submitted. A claim is skipped
+// when the prophecy has been finalized or the claim's validator has already made a claim on it.
+func CheckClaim(querier ProphecyQuerier, claim types.EthBridgeClaim) (ClaimCheck, error) {
+	params := types.NewQueryEthProphecyParams(claim.ProphecyID())
+	prophecy, found, err := querier.QueryEthProphecy(params)
+	if err != nil {
+		return ClaimCheck{}, err
+	}
+	if !found {
+		return ClaimCheck{Submit: true}, nil
+	}
+
+	check := ClaimCheck{Submit: true}
+	ownClaim := oracleClaimString(claim)
+
+	leadingClaim, leadingValidators := leadingOracleClaim(prophecy.EthBridgeClaims)
+	if prophecy.Status.StatusText != oracletypes.PendingStatusText {
+		check.Submit = false
+		check.Reason = fmt.Sprintf("prophecy already finalized with status %s", prophecy.Status.StatusText)
+		if prophecy.Status.FinalClaim != "" {
+			leadingClaim = prophecy.Status.FinalClaim
+		}
+	}
+	for _, existing := range prophecy.EthBridgeClaims {
+		if existing.Validator.Equals(claim.Validator) && check.Submit {
+			check.Submit = false
+			check.Reason = "validator has already made a claim"
+		}
+	}
+
+	if leadingClaim != "" && leadingClaim != ownClaim {
+		oracleClaim, err := types.CreateOracleClaimFromOracleString(leadingClaim)
+		if err != nil {
+			return ClaimCheck{}, err
+		}
+		check.Mismatch = true
+		check.LeadingClaim = oracleClaim
+		check.LeadingValidators = leadingValidators
+	}
+	return check, nil
+}
+
+// leadingOracleClaim returns the claim made by the most validators, and how many made it. Ties are broken by
+// the claims' order so that the result does not depend on the order of the query response.
+func leadingOracleClaim(claims []types.EthBridgeClaim) (string, int) {
+	validators := make(map[string]int)
+	for _, claim := range claims {
+		validators[oracleClaimString(claim)]++
+	}
+
+	var contents []string
+	for content := range validators {
+		contents = append(contents, content)
+	}
+	sort.Strings(contents)
+
+	leading, leadingValidators := "", 0
+	for _, content := range contents {
+		if validators[content] > leadingValidators {
+			leading, leadingValidators = content, validators[content]
+		}
+	}
+	return leading, leadingValidators
+}
+
+// oracleClaimString returns the claim as stored by the oracle, so that claims are compared like the oracle does
+func oracleClaimString(claim types.EthBridgeClaim) string {
+	bz, _ := json.Marshal(types.NewOracleClaim(claim.EthereumSender, claim.Nonce, claim.CosmosReceiver, claim.Amount, claim.EthereumProvenance))
+	return string(bz)
+}
+
+// abciError is the log of a failed ABCI query, from which the error's codespace and code are read
+type abciError struct {
+	Codespace sdk.CodespaceType `json:"codespace"`
+	Code      sdk.CodeType      `json:"code"`
+}
+
+// QueryEthProphecy queries the node for the prophecy on a lock
+func (b CLIBroadcaster) QueryEthProphecy(params types.QueryEthProphecyParams) (types.QueryEthProphecyResponse, bool, error) {
+	bz, err := b.cliCtx.Codec.MarshalJSON(params)
+	if err != nil {
+		return types.QueryEthProphecyResponse{}, false, err
+	}
+
+	route := fmt.Sprintf("custom/%s/%s", ethbridge.QuerierRoute, ethbridge.QueryEthProphecy)
+	res, err := b.cliCtx.QueryWithData(route, bz)
+	if err != nil {
+		if isProphecyNotFound(err) {
+			return types.QueryEthProphecyResponse{}, false, nil
+		}
+		return types.QueryEthProphecyResponse{}, false, err
+	}
+
+	var prophecy types.QueryEthProphecyResponse
+	if err := b.cliCtx.Codec.UnmarshalJSON(res, &prophecy); err != nil {
+		return types.QueryEthProphecyResponse{}, false, err
+	}
+	return prophecy, true, nil
+}
+
+// QueryPendingProphecies queries the node for the pending prophecies on locks made on an ethereum chain
+func (b CLIBroadcaster) QueryPendingProphecies(ethereumChainID int) ([]types.QueryEthProphecyResponse, error) {
+	bz, err := b.cliCtx.Codec.MarshalJSON(types.NewQueryPendingPropheciesParams(ethereumChainID))
+	if err != nil {
+		return nil, err
+	}
+
+	route := fmt.Sprintf("custom/%s/%s", ethbridge.QuerierRoute, ethbridge.QueryPendingProphecies)
+	res, err := b.cliCtx.QueryWithData(route, bz)
+	if err != nil {
+		return nil, err
+	}
+
+	var prophecies []types.QueryEthProphecyResponse
+	if err := b.cliCtx.Codec.UnmarshalJSON(res, &prophecies); err != nil {
+		return nil, err
+	}
+	return prophecies, nil
+}
+
+// isProphecyNotFound returns true if a query failed with the oracle's ErrProphecyNotFound
+func isProphecyNotFound(err error) bool {
+	var abciErr abciError
+	if json.Unmarshal([]byte(err.Error()), &abciErr) != nil {
+		return false
+	}
+	return abciErr.Codespace == oracletypes.DefaultCodespace && abciErr.Code == oracletypes.CodeProphecyNotFound
+}
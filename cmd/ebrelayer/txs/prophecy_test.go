@@ -0,0 +1,96 @@
+package txs
+
+import (
+	"errors"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/swishlabsco/cosmos-ethereum-bridge/x/ethbridge/types"
+	oracletypes "github.com/swishlabsco/cosmos-ethereum-bridge/x/oracle/types"
+)
+
+// fakeProphecyQuerier returns a fixed prophecy for every query
+type fakeProphecyQuerier struct {
+	prophecy types.QueryEthProphecyResponse
+	found    bool
+	err      error
+}
+
+func (q fakeProphecyQuerier) QueryEthProphecy(params types.QueryEthProphecyParams) (types.QueryEthProphecyResponse, bool, error) {
+	return q.prophecy, q.found, q.err
+}
+
+func (q fakeProphecyQuerier) QueryPendingProphecies(ethereumChainID int) ([]types.QueryEthProphecyResponse, error) {
+	if !q.found {
+		return nil, q.err
+	}
+	return []types.QueryEthProphecyResponse{q.prophecy}, q.err
+}
+
+func otherValidator(seed byte) sdk.AccAddress {
+	return sdk.AccAddress([]byte{seed, seed, seed, seed, seed, seed, seed, seed, seed, seed, seed, seed, seed, seed, seed, seed, seed, seed, seed, seed})
+}
+
+func TestCheckClaim(t *testing.T) {
+	claim := createTestClaim(t, 1)
+	pending := oracletypes.NewStatus(oracletypes.PendingStatusText, "")
+
+	// Claims on locks without a prophecy are submitted
+	check, err := CheckClaim(fakeProphecyQuerier{}, claim)
+	require.NoError(t, err)
+	require.True(t, check.Submit)
+	require.False(t, check.Mismatch)
+
+	_, err = CheckClaim(fakeProphecyQuerier{err: errors.New("connection refused")}, claim)
+	require.Error(t, err)
+
+	// Another validator made the same claim
+	agreeing := claim
+	agreeing.Validator = otherValidator(1)
+	querier := fakeProphecyQuerier{
+		found:    true,
+		prophecy: types.NewQueryEthProphecyResponse(claim.ProphecyID(), pending, []types.EthBridgeClaim{agreeing}),
+	}
+	check, err = CheckClaim(querier, claim)
+	require.NoError(t, err)
+	require.True(t, check.Submit)
+	require.False(t, check.Mismatch)
+
+	// This validator already made its claim
+	querier.prophecy.EthBridgeClaims = append(querier.prophecy.EthBridgeClaims, claim)
+	check, err = CheckClaim(querier, claim)
+	require.NoError(t, err)
+	require.False(t, check.Submit)
+	require.Equal(t, "validator has already made a claim", check.Reason)
+	require.False(t, check.Mismatch)
+
+	// Two validators claim a different amount, which leads the prophecy
+	differing := agreeing
+	differing.Amount = sdk.Coins{sdk.NewInt64Coin("ethereum", 1)}
+	differing.Validator = otherValidator(2)
+	differing2 := differing
+	differing2.Validator = otherValidator(3)
+	querier.prophecy.EthBridgeClaims = []types.EthBridgeClaim{agreeing, differing, differing2}
+	check, err = CheckClaim(querier, claim)
+	require.NoError(t, err)
+	require.True(t, check.Submit)
+	require.True(t, check.Mismatch)
+	require.Equal(t, differing.Amount, check.LeadingClaim.Amount)
+	require.Equal(t, 2, check.LeadingValidators)
+
+	// A finalized prophecy is never claimed on, and its final claim is compared against
+	querier.prophecy.Status = oracletypes.NewStatus(oracletypes.SuccessStatusText, oracleClaimString(agreeing))
+	check, err = CheckClaim(querier, claim)
+	require.NoError(t, err)
+	require.False(t, check.Submit)
+	require.Equal(t, "prophecy already finalized with status success", check.Reason)
+	require.False(t, check.Mismatch)
+}
+
+func TestIsProphecyNotFound(t *testing.T) {
+	require.True(t, isProphecyNotFound(errors.New(oracletypes.ErrProphecyNotFound(oracletypes.DefaultCodespace).ABCILog())))
+	require.False(t, isProphecyNotFound(errors.New(oracletypes.ErrInvalidClaim(oracletypes.DefaultCodespace).ABCILog())))
+	require.False(t, isProphecyNotFound(errors.New("connection refused")))
+}
@@ -0,0 +1,86 @@
+package txs
+
+// --------------------------------------------------------
+//      Sequence
+//
+//      Tracks the relaying validator's account sequence
+//      locally so that transactions can be signed and
+//      broadcast concurrently without querying the node.
+// --------------------------------------------------------
+
+import (
+	"regexp"
+	"strconv"
+	"sync"
+)
+
+// expectedSequenceRegex matches the sequence expected by the ante handler in an ErrInvalidSequence log
+var expectedSequenceRegex = regexp.MustCompile(`expected (\d+)`)
+
+// SequenceTracker hands out account sequences for transactions signed by a single account
+type SequenceTracker struct {
+	mtx           sync.Mutex
+	accountNumber uint64
+	sequence      uint64
+}
+
+// NewSequenceTracker returns a SequenceTracker starting at the given account number and sequence
+func NewSequenceTracker(accountNumber uint64, sequence uint64) *SequenceTracker {
+	return &SequenceTracker{
+		accountNumber: accountNumber,
+		sequence:      sequence,
+	}
+}
+
+// AccountNumber returns the tracked account's number
+func (st *SequenceTracker) AccountNumber() uint64 {
+	st.mtx.Lock()
+	defer st.mtx.Unlock()
+	return st.accountNumber
+}
+
+// Next reserves and returns the next unused sequence
+func (st *SequenceTracker) Next() uint64 {
+	st.mtx.Lock()
+	defer st.mtx.Unlock()
+	sequence := st.sequence
+	st.sequence++
+	return sequence
+}
+
+// Current returns the next sequence which would be handed out, without reserving it
+func (st *SequenceTracker) Current() uint64 {
+	st.mtx.Lock()
+	defer st.mtx.Unlock()
+	return st.sequence
+}
+
+// Reset sets the next sequence to be handed out
+func (st *SequenceTracker) Reset(sequence uint64) {
+	st.mtx.Lock()
+	defer st.mtx.Unlock()
+	st.sequence = sequence
+}
+
+// Rewind moves the next sequence back to the given one if it has already been handed out,
+// used when a transaction was rejected without consuming its sequence
+func (st *SequenceTracker) Rewind(sequence uint64) {
+	st.mtx.Lock()
+	defer st.mtx.Unlock()
+	if sequence < st.sequence {
+		st.sequence = sequence
+	}
+}
+
+// ParseExpectedSequence extracts the expected sequence from an ErrInvalidSequence log
+func ParseExpectedSequence(log string) (uint64, bool) {
+	matches := expectedSequenceRegex.FindStringSubmatch(log)
+	if len(matches) != 2 {
+		return 0, false
+	}
+	sequence, err := strconv.ParseUint(matches[1], 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return sequence, true
+}
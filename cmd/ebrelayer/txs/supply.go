@@ -0,0 +1,32 @@
+package txs
+
+// --------------------------------------------------------
+//      Supply
+//
+//      Queries the coins minted by the Cosmos bridge, to be
+//      compared with the value locked on Ethereum.
+// --------------------------------------------------------
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/swishlabsco/cosmos-ethereum-bridge/x/ethbridge"
+)
+
+// QueryBridgedSupply queries the node for the coins minted by the bridge which have not been clawed back
+func QueryBridgedSupply(cliCtx context.CLIContext) (sdk.Coins, error) {
+	route := fmt.Sprintf("custom/%s/%s", ethbridge.QuerierRoute, ethbridge.QueryBridgedSupply)
+	res, err := cliCtx.QueryWithData(route, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var supply sdk.Coins
+	if err := cliCtx.Codec.UnmarshalJSON(res, &supply); err != nil {
+		return nil, err
+	}
+	return supply, nil
+}
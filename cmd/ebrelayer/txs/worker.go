@@ -0,0 +1,328 @@
+package txs
+
+// --------------------------------------------------------
+//      Worker
+//
+//      Queues ethbridge msgs and relays them concurrently
+//      from a pool of workers sharing a local account
+//      sequence, optionally batching several into one tx.
+// --------------------------------------------------------
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+const (
+	DefaultNumWorkers = 4
+	DefaultQueueSize  = 256
+	DefaultBatchSize  = 1
+)
+
+// ErrQueueFull is returned when a msg is enqueued while the relay queue is at capacity
+var ErrQueueFull = errors.New("relay queue is full")
+
+// WorkerMetrics records the latency and account sequence of the worker's broadcasts
+type WorkerMetrics interface {
+	ObserveBroadcastLatency(latency time.Duration)
+	SetAccountSequence(sequence uint64)
+}
+
+type nopWorkerMetrics struct{}
+
+func (nopWorkerMetrics) ObserveBroadcastLatency(time.Duration) {}
+func (nopWorkerMetrics) SetAccountSequence(uint64)             {}
+
+// RelayResult is the final outcome of relaying a batch of msgs in a single transaction
+type RelayResult struct {
+	Msgs     []sdk.Msg
+	Outcome  Outcome
+	Attempts int
+	Response sdk.TxResponse
+	Err      error
+}
+
+// RelayWorker relays queued msgs through a Broadcaster using a pool of goroutines
+type RelayWorker struct {
+	broadcaster Broadcaster
+	sequence    *SequenceTracker
+
+	queue   chan sdk.Msg
+	batches chan []sdk.Msg
+
+	numWorkers int
+	batchSize  int
+	policy     RetryPolicy
+
+	onResult func(RelayResult)
+	metrics  WorkerMetrics
+	logger   log.Logger
+
+	wg sync.WaitGroup
+}
+
+// NewRelayWorker creates a RelayWorker with a bounded queue. A batchSize greater than one allows
+// multiple msgs to be sent in the same transaction when events arrive in bursts.
+func NewRelayWorker(broadcaster Broadcaster, numWorkers int, queueSize int, batchSize int, policy RetryPolicy,
+	onResult func(RelayResult)) *RelayWorker {
+
+	if numWorkers < 1 {
+		numWorkers = DefaultNumWorkers
+	}
+	if queueSize < 1 {
+		queueSize = DefaultQueueSize
+	}
+	if batchSize < 1 {
+		batchSize = DefaultBatchSize
+	}
+	if policy.MaxAttempts < 1 {
+		policy.MaxAttempts = 1
+	}
+	if policy.GasAdjustment < 1 {
+		policy.GasAdjustment = 1
+	}
+	if onResult == nil {
+		onResult = func(RelayResult) {}
+	}
+
+	return &RelayWorker{
+		broadcaster: broadcaster,
+		queue:       make(chan sdk.Msg, queueSize),
+		batches:     make(chan []sdk.Msg, numWorkers),
+		numWorkers:  numWorkers,
+		batchSize:   batchSize,
+		policy:      policy,
+		onResult:    onResult,
+		metrics:     nopWorkerMetrics{},
+		logger:      log.NewNopLogger(),
+	}
+}
+
+// SetLogger sets the logger the worker's broadcasts are logged to. It must be called before Start.
+func (w *RelayWorker) SetLogger(logger log.Logger) {
+	w.logger = logger
+}
+
+// SetMetrics sets the metrics the worker's broadcasts are recorded in. It must be called before Start.
+func (w *RelayWorker) SetMetrics(metrics WorkerMetrics) {
+	w.metrics = metrics
+}
+
+// Start loads the account's sequence from the node and launches the dispatcher and workers
+func (w *RelayWorker) Start() error {
+	accountNumber, sequence, err := w.broadcaster.AccountInfo()
+	if err != nil {
+		return err
+	}
+	w.sequence = NewSequenceTracker(accountNumber, sequence)
+
+	w.wg.Add(1)
+	go w.dispatch()
+
+	for i := 0; i < w.numWorkers; i++ {
+		w.wg.Add(1)
+		go w.work()
+	}
+	return nil
+}
+
+// Stop closes the queue and waits for all queued msgs to be relayed
+func (w *RelayWorker) Stop() {
+	close(w.queue)
+	w.wg.Wait()
+}
+
+// Enqueue validates a msg and adds it to the relay queue without blocking
+func (w *RelayWorker) Enqueue(msg sdk.Msg) error {
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	select {
+	case w.queue <- msg:
+		return nil
+	default:
+		return ErrQueueFull
+	}
+}
+
+// dispatch groups queued msgs into batches of up to batchSize, without waiting for a batch to fill
+func (w *RelayWorker) dispatch() {
+	defer w.wg.Done()
+	defer close(w.batches)
+
+	for msg := range w.queue {
+		batch := []sdk.Msg{msg}
+
+	drain:
+		for len(batch) < w.batchSize {
+			select {
+			case next, ok := <-w.queue:
+				if !ok {
+					break drain
+				}
+				batch = append(batch, next)
+			default:
+				break drain
+			}
+		}
+
+		w.batches <- batch
+	}
+}
+
+func (w *RelayWorker) work() {
+	defer w.wg.Done()
+
+	for batch := range w.batches {
+		for _, result := range w.relay(batch) {
+			w.onResult(result)
+		}
+	}
+}
+
+// relay sends a batch of msgs, falling back to sending each msg on its own when a batched
+// transaction is rejected, since a single bad msg fails every other msg in its transaction
+func (w *RelayWorker) relay(batch []sdk.Msg) []RelayResult {
+	result := w.relayBatch(batch)
+	if len(batch) == 1 || result.Outcome == OutcomeCommitted {
+		return []RelayResult{result}
+	}
+
+	results := make([]RelayResult, len(batch))
+	for i, msg := range batch {
+		results[i] = w.relayBatch([]sdk.Msg{msg})
+	}
+	return results
+}
+
+// relayBatch broadcasts a batch as a single transaction and waits for it to be included in a block,
+// retrying with backoff while the outcome is retryable
+func (w *RelayWorker) relayBatch(msgs []sdk.Msg) RelayResult {
+	result := RelayResult{Msgs: msgs}
+	logger := w.logger.With("prophecy_ids", batchProphecyIDs(msgs))
+	gasAdjustment := 1.0
+	for attempt := 1; attempt <= w.policy.MaxAttempts; attempt++ {
+		if attempt > 1 {
+			logger.Info("Retrying tx", "attempt", attempt, "err", result.Err)
+			if !IsInvalidSequence(result.Response) {
+				time.Sleep(w.policy.Backoff(attempt - 1))
+			}
+		}
+		result.Attempts = attempt
+
+		sequence := w.sequence.Next()
+		logger.Debug("Broadcasting tx", "msgs", len(msgs), "sequence", sequence, "gas_adjustment", gasAdjustment)
+		start := time.Now()
+		res, err := w.broadcaster.Broadcast(msgs, w.sequence.AccountNumber(), sequence, gasAdjustment)
+		w.metrics.ObserveBroadcastLatency(time.Since(start))
+		result.Response = res
+		if err != nil {
+			w.sequence.Rewind(sequence)
+			result.Outcome = OutcomeRetryable
+			result.Err = err
+			continue
+		}
+
+		if IsInvalidSequence(res) {
+			w.resyncSequence(ResponseLog(res))
+			logger.Info("Resynced account sequence", "sequence", sequence, "next_sequence", w.sequence.Current())
+			result.Outcome = OutcomeRetryable
+			result.Err = fmt.Errorf("invalid sequence %d: %s", sequence, ResponseLog(res))
+			continue
+		}
+
+		// A tx rejected by CheckTx does not consume its sequence
+		if res.Code != uint32(sdk.CodeOK) {
+			w.sequence.Rewind(sequence)
+		} else {
+			w.metrics.SetAccountSequence(sequence)
+			logger.Debug("Waiting for tx to be included", "cosmos_tx_hash", res.TxHash, "sequence", sequence)
+			res, err = w.confirm(res.TxHash)
+			result.Response = res
+			if err != nil {
+				result.Outcome = OutcomeRetryable
+				result.Err = err
+				continue
+			}
+		}
+
+		result.Outcome = ClassifyResponse(res.Codespace, res.Code)
+		if result.Outcome == OutcomeCommitted {
+			result.Err = nil
+			return result
+		}
+		result.Err = fmt.Errorf("msg %s with code %d in codespace %s: %s", result.Outcome, res.Code, res.Codespace, ResponseLog(res))
+		if result.Outcome.IsTerminal() {
+			return result
+		}
+		if res.Code == uint32(sdk.CodeOutOfGas) {
+			gasAdjustment *= w.policy.GasAdjustment
+		}
+	}
+
+	logger.Error("Giving up on tx", "attempts", result.Attempts, "err", result.Err)
+	result.Outcome = OutcomeFailed
+	return result
+}
+
+// batchProphecyIDs joins the prophecy ids of a batch's msgs, to identify the batch in the logs
+func batchProphecyIDs(msgs []sdk.Msg) string {
+	prophecyIDs := make([]string, len(msgs))
+	for i, msg := range msgs {
+		prophecyIDs[i] = MsgProphecyID(msg)
+	}
+	return strings.Join(prophecyIDs, ",")
+}
+
+// confirm polls the node until the transaction is included in a block or the confirm timeout elapses
+func (w *RelayWorker) confirm(txHash string) (sdk.TxResponse, error) {
+	deadline := time.Now().Add(w.policy.ConfirmTimeout)
+	for {
+		res, err := w.broadcaster.QueryTx(txHash)
+		if err == nil {
+			return res, nil
+		}
+		if time.Now().After(deadline) {
+			return sdk.TxResponse{TxHash: txHash}, fmt.Errorf("tx %s not included after %v: %v", txHash, w.policy.ConfirmTimeout, err)
+		}
+		time.Sleep(w.policy.PollInterval)
+	}
+}
+
+// resyncSequence resets the local sequence from the node's error log, or by querying the account
+func (w *RelayWorker) resyncSequence(log string) {
+	if expected, ok := ParseExpectedSequence(log); ok {
+		w.sequence.Reset(expected)
+		return
+	}
+
+	_, sequence, err := w.broadcaster.AccountInfo()
+	if err != nil {
+		return
+	}
+	w.sequence.Reset(sequence)
+}
+
+// IsInvalidSequence returns true if the response is an ErrInvalidSequence from the ante handler
+func IsInvalidSequence(res sdk.TxResponse) bool {
+	if res.Codespace != "" && res.Codespace != string(sdk.CodespaceRoot) {
+		return false
+	}
+	return res.Code == uint32(sdk.CodeInvalidSequence)
+}
+
+// ResponseLog returns the logs of a tx response's messages as one text
+func ResponseLog(res sdk.TxResponse) string {
+	logs := make([]string, len(res.Logs))
+	for i, msgLog := range res.Logs {
+		logs[i] = msgLog.Log
+	}
+	return strings.Join(logs, "\n")
+}
@@ -0,0 +1,311 @@
+package txs
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/swishlabsco/cosmos-ethereum-bridge/x/ethbridge"
+	"github.com/swishlabsco/cosmos-ethereum-bridge/x/ethbridge/types"
+	oracletypes "github.com/swishlabsco/cosmos-ethereum-bridge/x/oracle/types"
+)
+
+var testPolicy = RetryPolicy{
+	MaxAttempts:    20,
+	InitialBackoff: time.Millisecond,
+	MaxBackoff:     5 * time.Millisecond,
+	ConfirmTimeout: 50 * time.Millisecond,
+	PollInterval:   time.Millisecond,
+	GasAdjustment:  1.5,
+}
+
+// mockBroadcaster enforces sequences like the ante handler, records every relayed msg and
+// returns queued DeliverTx failures for msgs by nonce
+type mockBroadcaster struct {
+	mtx      sync.Mutex
+	sequence uint64
+	txs      int
+	relayed  map[int]int
+	results  map[string]sdk.TxResponse
+	failures map[int][]sdk.TxResponse
+}
+
+func newMockBroadcaster(sequence uint64) *mockBroadcaster {
+	return &mockBroadcaster{
+		sequence: sequence,
+		relayed:  make(map[int]int),
+		results:  make(map[string]sdk.TxResponse),
+		failures: make(map[int][]sdk.TxResponse),
+	}
+}
+
+func (b *mockBroadcaster) AccountInfo() (uint64, uint64, error) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	return 1, b.sequence, nil
+}
+
+func (b *mockBroadcaster) Broadcast(msgs []sdk.Msg, accountNumber uint64, sequence uint64, gasAdjustment float64) (sdk.TxResponse, error) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	if sequence != b.sequence {
+		return sdk.TxResponse{
+			Code:      uint32(sdk.CodeInvalidSequence),
+			Codespace: string(sdk.CodespaceRoot),
+			Logs:      sdk.ABCIMessageLogs{{Log: fmt.Sprintf("Invalid sequence. Got %d, expected %d", sequence, b.sequence)}},
+		}, nil
+	}
+
+	b.sequence++
+	b.txs++
+	txHash := strconv.Itoa(b.txs)
+
+	for _, msg := range msgs {
+		nonce := msgNonce(msg)
+		if failures := b.failures[nonce]; len(failures) > 0 {
+			failure := failures[0]
+			failure.TxHash = txHash
+			b.failures[nonce] = failures[1:]
+			b.results[txHash] = failure
+			return sdk.TxResponse{Code: uint32(sdk.CodeOK), TxHash: txHash}, nil
+		}
+	}
+
+	for _, msg := range msgs {
+		b.relayed[msgNonce(msg)]++
+	}
+	b.results[txHash] = sdk.TxResponse{Code: uint32(sdk.CodeOK), TxHash: txHash, Height: 1}
+	return sdk.TxResponse{Code: uint32(sdk.CodeOK), TxHash: txHash}, nil
+}
+
+func (b *mockBroadcaster) QueryTx(txHash string) (sdk.TxResponse, error) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	res, ok := b.results[txHash]
+	if !ok {
+		return sdk.TxResponse{}, fmt.Errorf("tx %s not found", txHash)
+	}
+	return res, nil
+}
+
+func msgNonce(msg sdk.Msg) int {
+	switch msg := msg.(type) {
+	case ethbridge.MsgMakeEthBridgeClaim:
+		return int(msg.Nonce.Uint64())
+	case ethbridge.MsgRevokeEthBridgeClaim:
+		return int(msg.Nonce.Uint64())
+	default:
+		return -1
+	}
+}
+
+func createTestClaim(t *testing.T, nonce int) types.EthBridgeClaim {
+	claim, err := ParsePayload(TestEthereumChainID, TestValidator, TestLog, &TestEventData)
+	require.NoError(t, err)
+	claim.ItemID = common.BigToHash(big.NewInt(int64(nonce))).Hex()
+	claim.Nonce = sdk.NewUint(uint64(nonce))
+	return claim
+}
+
+func createTestMsg(t *testing.T, nonce int) sdk.Msg {
+	return ethbridge.NewMsgMakeEthBridgeClaim(createTestClaim(t, nonce))
+}
+
+func TestRelayWorkerConcurrent(t *testing.T) {
+	broadcaster := newMockBroadcaster(5)
+
+	var mtx sync.Mutex
+	var failures []RelayResult
+	worker := NewRelayWorker(broadcaster, 4, 64, 1, testPolicy, func(result RelayResult) {
+		if result.Err != nil {
+			mtx.Lock()
+			failures = append(failures, result)
+			mtx.Unlock()
+		}
+	})
+	require.NoError(t, worker.Start())
+
+	for i := 1; i <= 50; i++ {
+		require.NoError(t, worker.Enqueue(createTestMsg(t, i)))
+	}
+	worker.Stop()
+
+	require.Empty(t, failures)
+	require.Equal(t, 50, len(broadcaster.relayed))
+	for i := 1; i <= 50; i++ {
+		require.Equal(t, 1, broadcaster.relayed[i])
+	}
+	require.Equal(t, uint64(55), broadcaster.sequence)
+}
+
+func TestRelayWorkerResyncSequence(t *testing.T) {
+	broadcaster := newMockBroadcaster(3)
+
+	var results []RelayResult
+	worker := NewRelayWorker(broadcaster, 1, 8, 1, testPolicy, func(result RelayResult) {
+		results = append(results, result)
+	})
+	require.NoError(t, worker.Start())
+
+	// Another client has used the account since the worker started
+	broadcaster.sequence = 7
+
+	require.NoError(t, worker.Enqueue(createTestMsg(t, 1)))
+	worker.Stop()
+
+	require.Len(t, results, 1)
+	require.NoError(t, results[0].Err)
+	require.Equal(t, 1, broadcaster.relayed[1])
+	require.Equal(t, uint64(8), broadcaster.sequence)
+}
+
+// recordingMetrics records the worker's broadcasts
+type recordingMetrics struct {
+	mtx        sync.Mutex
+	broadcasts int
+	sequence   uint64
+}
+
+func (m *recordingMetrics) ObserveBroadcastLatency(time.Duration) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	m.broadcasts++
+}
+
+func (m *recordingMetrics) SetAccountSequence(sequence uint64) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	m.sequence = sequence
+}
+
+func TestRelayWorkerMetrics(t *testing.T) {
+	broadcaster := newMockBroadcaster(3)
+	metrics := &recordingMetrics{}
+	worker := NewRelayWorker(broadcaster, 1, 8, 1, testPolicy, nil)
+	worker.SetMetrics(metrics)
+	require.NoError(t, worker.Start())
+
+	// The first broadcast is rejected for its sequence and is not recorded as accepted
+	broadcaster.sequence = 7
+
+	require.NoError(t, worker.Enqueue(createTestMsg(t, 1)))
+	require.NoError(t, worker.Enqueue(createTestMsg(t, 2)))
+	worker.Stop()
+
+	require.Equal(t, 3, metrics.broadcasts)
+	require.Equal(t, uint64(8), metrics.sequence)
+}
+
+func TestRelayWorkerBatching(t *testing.T) {
+	broadcaster := newMockBroadcaster(0)
+	worker := NewRelayWorker(broadcaster, 1, 64, 10, testPolicy, nil)
+
+	// Queue the claims before starting so that they are available to be batched together
+	for i := 1; i <= 25; i++ {
+		require.NoError(t, worker.Enqueue(createTestMsg(t, i)))
+	}
+	require.NoError(t, worker.Start())
+	worker.Stop()
+
+	require.Equal(t, 25, len(broadcaster.relayed))
+	require.Equal(t, 3, broadcaster.txs)
+}
+
+func TestRelayWorkerQueueFull(t *testing.T) {
+	worker := NewRelayWorker(newMockBroadcaster(0), 1, 2, 1, testPolicy, nil)
+
+	require.NoError(t, worker.Enqueue(createTestMsg(t, 1)))
+	require.NoError(t, worker.Enqueue(createTestMsg(t, 2)))
+	require.Equal(t, ErrQueueFull, worker.Enqueue(createTestMsg(t, 3)))
+
+	badClaim := createTestClaim(t, 4)
+	badClaim.EthereumSender = "badAddress"
+	require.Error(t, worker.Enqueue(ethbridge.NewMsgMakeEthBridgeClaim(badClaim)))
+}
+
+func TestRelayWorkerRetryPolicy(t *testing.T) {
+	broadcaster := newMockBroadcaster(0)
+
+	// Nonce 1 runs out of gas once before succeeding, nonce 2 has already been claimed by this validator
+	broadcaster.failures[1] = []sdk.TxResponse{
+		{Code: uint32(sdk.CodeOutOfGas), Codespace: string(sdk.CodespaceRoot), Logs: sdk.ABCIMessageLogs{{Log: "out of gas"}}},
+	}
+	broadcaster.failures[2] = []sdk.TxResponse{
+		{Code: uint32(oracletypes.CodeDuplicateMessage), Codespace: string(oracletypes.DefaultCodespace)},
+	}
+
+	results := make(map[int]RelayResult)
+	worker := NewRelayWorker(broadcaster, 1, 8, 1, testPolicy, func(result RelayResult) {
+		results[msgNonce(result.Msgs[0])] = result
+	})
+	require.NoError(t, worker.Start())
+	require.NoError(t, worker.Enqueue(createTestMsg(t, 1)))
+	require.NoError(t, worker.Enqueue(createTestMsg(t, 2)))
+	worker.Stop()
+
+	require.Equal(t, OutcomeCommitted, results[1].Outcome)
+	require.Equal(t, 2, results[1].Attempts)
+	require.NoError(t, results[1].Err)
+
+	require.Equal(t, OutcomeAlreadyProcessed, results[2].Outcome)
+	require.Equal(t, 1, results[2].Attempts)
+	require.Error(t, results[2].Err)
+	require.Equal(t, 0, broadcaster.relayed[2])
+}
+
+func TestRelayWorkerRevocations(t *testing.T) {
+	broadcaster := newMockBroadcaster(0)
+
+	var results []RelayResult
+	worker := NewRelayWorker(broadcaster, 1, 8, 10, testPolicy, func(result RelayResult) {
+		results = append(results, result)
+	})
+
+	revocation, err := ParseRevocationPayload(TestEthereumChainID, TestLog.Address, TestValidator, TestEventData.Id, TestEventData.Nonce, TestEventData.From, types.RevocationReasonWithdraw)
+	require.NoError(t, err)
+
+	badRevocation := revocation
+	badRevocation.Reason = "stolen"
+	require.Error(t, worker.Enqueue(ethbridge.NewMsgRevokeEthBridgeClaim(badRevocation)))
+
+	// Claims and revocations are batched together into the same transaction
+	require.NoError(t, worker.Enqueue(createTestMsg(t, 1)))
+	require.NoError(t, worker.Enqueue(ethbridge.NewMsgRevokeEthBridgeClaim(revocation)))
+	require.NoError(t, worker.Start())
+	worker.Stop()
+
+	require.Len(t, results, 1)
+	require.Equal(t, OutcomeCommitted, results[0].Outcome)
+	require.Len(t, results[0].Msgs, 2)
+	require.Equal(t, 1, broadcaster.relayed[1])
+	require.Equal(t, 1, broadcaster.relayed[39])
+	require.Equal(t, 1, broadcaster.txs)
+}
+
+func TestClassifyResponse(t *testing.T) {
+	require.Equal(t, OutcomeCommitted, ClassifyResponse("", uint32(sdk.CodeOK)))
+	require.Equal(t, OutcomeAlreadyProcessed, ClassifyResponse(string(oracletypes.DefaultCodespace), uint32(oracletypes.CodeProphecyFinalized)))
+	require.Equal(t, OutcomeAlreadyProcessed, ClassifyResponse(string(oracletypes.DefaultCodespace), uint32(oracletypes.CodeDuplicateMessage)))
+	require.Equal(t, OutcomeRejected, ClassifyResponse(string(oracletypes.DefaultCodespace), uint32(oracletypes.CodeInvalidValidator)))
+	require.Equal(t, OutcomeRejected, ClassifyResponse(string(types.DefaultCodespace), uint32(types.CodeInvalidEthAddress)))
+	require.Equal(t, OutcomeRetryable, ClassifyResponse(string(sdk.CodespaceRoot), uint32(sdk.CodeOutOfGas)))
+	require.Equal(t, OutcomeRejected, ClassifyResponse(string(sdk.CodespaceRoot), uint32(sdk.CodeUnauthorized)))
+}
+
+func TestParseExpectedSequence(t *testing.T) {
+	sequence, ok := ParseExpectedSequence("Invalid sequence. Got 4, expected 12")
+	require.True(t, ok)
+	require.Equal(t, uint64(12), sequence)
+
+	_, ok = ParseExpectedSequence("out of gas")
+	require.False(t, ok)
+}
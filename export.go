@@ -12,6 +12,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/swishlabsco/cosmos-ethereum-bridge/x/ethbridge"
 )
 
 // export the state of gaia for a genesis file
@@ -39,6 +40,7 @@ func (app *ethereumBridgeApp) ExportAppStateAndValidators(forZeroHeight bool, ja
 		auth.ExportGenesis(ctx, app.accountKeeper, app.feeCollectionKeeper),
 		bank.ExportGenesis(ctx, app.bankKeeper),
 		staking.ExportGenesis(ctx, app.stakingKeeper),
+		ethbridge.ExportGenesis(ctx, app.ethBridgeKeeper),
 	)
 	appState, err = codec.MarshalJSONIndent(app.cdc, genState)
 	if err != nil {
@@ -8,6 +8,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/swishlabsco/cosmos-ethereum-bridge/x/ethbridge"
 )
 
 type GenesisAccount struct {
@@ -26,11 +27,12 @@ type GenesisAccount struct {
 
 // GenesisState represents chain state at the start of the chain. Any initial state (account balances) are stored here.
 type GenesisState struct {
-	Accounts    []GenesisAccount     `json:"accounts"`
-	AuthData    auth.GenesisState    `json:"auth"`
-	BankData    bank.GenesisState    `json:"bank"`
-	StakingData staking.GenesisState `json:"staking"`
-	GenTxs      []json.RawMessage    `json:"gentxs"`
+	Accounts      []GenesisAccount       `json:"accounts"`
+	AuthData      auth.GenesisState      `json:"auth"`
+	BankData      bank.GenesisState      `json:"bank"`
+	StakingData   staking.GenesisState   `json:"staking"`
+	EthBridgeData ethbridge.GenesisState `json:"ethbridge"`
+	GenTxs        []json.RawMessage      `json:"gentxs"`
 }
 
 // convert GenesisAccount to auth.BaseAccount
@@ -70,13 +72,15 @@ func (ga *GenesisAccount) ToAccount() auth.Account {
 
 func NewGenesisState(accounts []GenesisAccount, authData auth.GenesisState,
 	bankData bank.GenesisState,
-	stakingData staking.GenesisState) GenesisState {
+	stakingData staking.GenesisState,
+	ethBridgeData ethbridge.GenesisState) GenesisState {
 
 	return GenesisState{
-		Accounts:    accounts,
-		AuthData:    authData,
-		BankData:    bankData,
-		StakingData: stakingData,
+		Accounts:      accounts,
+		AuthData:      authData,
+		BankData:      bankData,
+		StakingData:   stakingData,
+		EthBridgeData: ethBridgeData,
 	}
 }
 
@@ -6,29 +6,31 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/spf13/cobra"
 	"github.com/swishlabsco/cosmos-ethereum-bridge/x/ethbridge"
 	"github.com/swishlabsco/cosmos-ethereum-bridge/x/ethbridge/types"
 )
 
+const flagLimit = "limit"
+
 // GetCmdGetEthBridgeProphecy queries information about a specific prophecy
 func GetCmdGetEthBridgeProphecy(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
-		Use:   "get-prophecy nonce ethereum-sender",
+		Use:   "get-prophecy ethereum-chain-id bridge-contract-address item-id",
 		Short: "get prophecy",
-		Args:  cobra.ExactArgs(2),
+		Args:  cobra.ExactArgs(3),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
-			nonce := args[0]
 
-			nonceString, err := strconv.Atoi(nonce)
+			ethereumChainID, err := strconv.Atoi(args[0])
 			if err != nil {
-				fmt.Printf(err.Error())
+				fmt.Println(err)
 				return nil
 			}
-			ethereumSender := args[1]
 
-			bz, err := cdc.MarshalJSON(ethbridge.NewQueryEthProphecyParams(nonceString, ethereumSender))
+			prophecyID := ethbridge.NewProphecyID(ethereumChainID, args[1], args[2])
+			bz, err := cdc.MarshalJSON(ethbridge.NewQueryEthProphecyParams(prophecyID))
 			if err != nil {
 				return err
 			}
@@ -36,7 +38,7 @@ func GetCmdGetEthBridgeProphecy(queryRoute string, cdc *codec.Codec) *cobra.Comm
 			route := fmt.Sprintf("custom/%s/%s", queryRoute, ethbridge.QueryEthProphecy)
 			res, err := cliCtx.QueryWithData(route, bz)
 			if err != nil {
-				fmt.Printf(err.Error())
+				fmt.Println(err)
 				return nil
 			}
 
@@ -46,3 +48,422 @@ func GetCmdGetEthBridgeProphecy(queryRoute string, cdc *codec.Codec) *cobra.Comm
 		},
 	}
 }
+
+// GetCmdGetNonceProphecies queries the prophecies claiming a nonce of a bridge contract
+func GetCmdGetNonceProphecies(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "nonce-prophecies ethereum-chain-id bridge-contract-address nonce",
+		Short: "get the prophecies claiming a nonce of a bridge contract",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			ethereumChainID, err := strconv.Atoi(args[0])
+			if err != nil {
+				fmt.Println(err)
+				return nil
+			}
+
+			bridgeContract := args[1]
+			nonce, nonceErr := types.ParseNonce(args[2])
+			if nonceErr != nil {
+				fmt.Println(nonceErr)
+				return nil
+			}
+
+			bz, err := cdc.MarshalJSON(ethbridge.NewQueryNoncePropheciesParams(ethereumChainID, bridgeContract, nonce))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, ethbridge.QueryNonceProphecies)
+			res, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				fmt.Println(err)
+				return nil
+			}
+
+			var out types.QueryEthPropheciesResponse
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdGetNonceGaps queries the nonces of a bridge contract after its last finalized nonce which have not been
+// finalized
+func GetCmdGetNonceGaps(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "nonce-gaps ethereum-chain-id bridge-contract-address",
+		Short: "get the nonces of a bridge contract which are missing or pending after its last finalized nonce",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			ethereumChainID, err := strconv.Atoi(args[0])
+			if err != nil {
+				fmt.Println(err)
+				return nil
+			}
+
+			limit, err := cmd.Flags().GetInt(flagLimit)
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(ethbridge.NewQueryNonceGapsParams(ethereumChainID, args[1], limit))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, ethbridge.QueryNonceGaps)
+			res, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				fmt.Println(err)
+				return nil
+			}
+
+			var out types.QueryNonceGapsResponse
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+	cmd.Flags().Int(flagLimit, types.DefaultNonceGapsLimit, "Maximum number of gaps to list")
+	return cmd
+}
+
+// GetCmdGetPendingProphecies queries the lock prophecies on an ethereum chain which are still pending
+func GetCmdGetPendingProphecies(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "pending-prophecies ethereum-chain-id",
+		Short: "get the pending prophecies on locks made on an ethereum chain",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			ethereumChainID, err := strconv.Atoi(args[0])
+			if err != nil {
+				fmt.Println(err)
+				return nil
+			}
+
+			bz, err := cdc.MarshalJSON(ethbridge.NewQueryPendingPropheciesParams(ethereumChainID))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, ethbridge.QueryPendingProphecies)
+			res, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				fmt.Println(err)
+				return nil
+			}
+
+			var out types.QueryEthPropheciesResponse
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdGetBridgedSupply queries the coins minted by the bridge which have not been clawed back
+func GetCmdGetBridgedSupply(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "bridged-supply",
+		Short: "get the coins minted by the bridge which have not been clawed back",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, ethbridge.QueryBridgedSupply)
+			res, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				fmt.Println(err)
+				return nil
+			}
+
+			var out sdk.Coins
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdGetSupply queries the ethbridge module account and the coins the bridge has minted and burned
+func GetCmdGetSupply(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "supply",
+		Short: "get the ethbridge module account and the coins the bridge has minted into it and burned from it",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, ethbridge.QuerySupply)
+			res, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				fmt.Println(err)
+				return nil
+			}
+
+			var out types.QuerySupplyResponse
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdGetBridgeStatus queries the last status of an ethereum chain's bridge contract attested by validators
+func GetCmdGetBridgeStatus(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "bridge-status ethereum-chain-id",
+		Short: "get whether locking on the bridge contract is active or paused",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			ethereumChainID, err := strconv.Atoi(args[0])
+			if err != nil {
+				fmt.Println(err)
+				return nil
+			}
+
+			bz, err := cdc.MarshalJSON(ethbridge.NewQueryBridgeStatusParams(ethereumChainID))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, ethbridge.QueryBridgeStatus)
+			res, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				fmt.Println(err)
+				return nil
+			}
+
+			var out types.BridgeStatus
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdGetMintingStatus queries whether the bridge admin has paused minting
+func GetCmdGetMintingStatus(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "minting-status",
+		Short: "get whether minting is active or paused by the bridge admin, and how many mints are held",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, ethbridge.QueryMintingStatus)
+			res, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				fmt.Println(err)
+				return nil
+			}
+
+			var out types.QueryMintingStatusResponse
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdGetPausedMints queries the successful prophecies whose coins are held while minting is paused
+func GetCmdGetPausedMints(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "paused-mints",
+		Short: "get the successful prophecies whose coins are held while minting is paused",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, ethbridge.QueryPausedMints)
+			res, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				fmt.Println(err)
+				return nil
+			}
+
+			var out types.QueryEthPropheciesResponse
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdGetParams queries the ethbridge params
+func GetCmdGetParams(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "params",
+		Short: "get the ethbridge params, including the bridge fee",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, ethbridge.QueryParams)
+			res, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				fmt.Println(err)
+				return nil
+			}
+
+			var out types.Params
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdGetRelayerRewards queries the bridge fees a validator has earned by relaying claims
+func GetCmdGetRelayerRewards(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "relayer-rewards validator-address",
+		Short: "get the bridge fees a validator has earned by relaying claims and not withdrawn",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			validator, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(ethbridge.NewQueryRelayerRewardsParams(validator))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, ethbridge.QueryRelayerRewards)
+			res, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				fmt.Println(err)
+				return nil
+			}
+
+			var out types.RelayerReward
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdGetFeePool queries the bridge fees held by the ethbridge module account
+func GetCmdGetFeePool(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "fee-pool",
+		Short: "get the bridge fees held by the ethbridge module account and the validators they are owed to",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, ethbridge.QueryFeePool)
+			res, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				fmt.Println(err)
+				return nil
+			}
+
+			var out types.QueryFeePoolResponse
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdGetMintLimits queries the mint limits and the coins minted in the current window
+func GetCmdGetMintLimits(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "mint-limits",
+		Short: "get the mint limits, the coins minted in the current window and the number of mints delayed by the limits",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, ethbridge.QueryMintLimits)
+			res, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				fmt.Println(err)
+				return nil
+			}
+
+			var out types.QueryMintLimitsResponse
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdGetDelayedMints queries the successful prophecies whose coins are delayed by the mint limits
+func GetCmdGetDelayedMints(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "delayed-mints",
+		Short: "get the successful prophecies whose coins are delayed until they fit in the mint limits",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, ethbridge.QueryDelayedMints)
+			res, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				fmt.Println(err)
+				return nil
+			}
+
+			var out types.QueryDelayedMintsResponse
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdGetRefunds queries the locks an ethereum sender made to invalid cosmos receivers
+func GetCmdGetRefunds(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "refunds ethereum-sender",
+		Short: "get the locks an ethereum sender made to invalid cosmos receivers, which can be unlocked back to it while refundable",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			bz, err := cdc.MarshalJSON(ethbridge.NewQueryRefundsParams(args[0]))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, ethbridge.QueryRefunds)
+			res, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				fmt.Println(err)
+				return nil
+			}
+
+			var out types.QueryRefundsResponse
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdGetEscrowedMints queries the successful prophecies whose coins are held in escrow
+func GetCmdGetEscrowedMints(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "escrowed-mints",
+		Short: "get the successful prophecies whose coins are held in escrow, with their release height and disputes",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, ethbridge.QueryEscrowedMints)
+			res, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				fmt.Println(err)
+				return nil
+			}
+
+			var out types.QueryEscrowedMintsResponse
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
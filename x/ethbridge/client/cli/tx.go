@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"fmt"
 	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
@@ -13,12 +14,20 @@ import (
 	authtxb "github.com/cosmos/cosmos-sdk/x/auth/client/txbuilder"
 )
 
+// Flags describing the ethereum log a claim is made from
+const (
+	flagEthereumTxHash      = "ethereum-tx-hash"
+	flagEthereumBlockNumber = "ethereum-block-number"
+	flagEthereumBlockHash   = "ethereum-block-hash"
+	flagEthereumLogIndex    = "ethereum-log-index"
+)
+
 // GetCmdMakeEthBridgeClaim is the CLI command for making a claim on an ethereum prophecy
 func GetCmdMakeEthBridgeClaim(cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
-		Use:   "make-claim nonce ethereum-sender-address cosmos-receiver-address validator-address amount",
+	cmd := &cobra.Command{
+		Use:   "make-claim ethereum-chain-id bridge-contract-address item-id nonce ethereum-sender-address cosmos-receiver-address validator-address amount",
 		Short: "make a claim on an ethereum prophecy",
-		Args:  cobra.ExactArgs(5),
+		Args:  cobra.ExactArgs(8),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc).WithAccountDecoder(cdc)
 
@@ -28,28 +37,40 @@ func GetCmdMakeEthBridgeClaim(cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 
-			nonce, stringError := strconv.Atoi(args[0])
+			ethereumChainID, stringError := strconv.Atoi(args[0])
 			if stringError != nil {
 				return stringError
 			}
 
-			ethereumSender := args[1]
-			cosmosReceiver, err := sdk.AccAddressFromBech32(args[2])
+			bridgeContract := args[1]
+			itemID := args[2]
+			nonce, nonceErr := types.ParseNonce(args[3])
+			if nonceErr != nil {
+				return nonceErr
+			}
+
+			ethereumSender := args[4]
+			cosmosReceiver, err := sdk.AccAddressFromBech32(args[5])
 			if err != nil {
 				return err
 			}
 
-			validator, err := sdk.AccAddressFromBech32(args[3])
+			validator, err := sdk.AccAddressFromBech32(args[6])
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoins(args[7])
 			if err != nil {
 				return err
 			}
 
-			amount, err := sdk.ParseCoins(args[4])
+			provenance, err := ethereumProvenanceFromFlags(cmd)
 			if err != nil {
 				return err
 			}
 
-			ethBridgeClaim := types.NewEthBridgeClaim(nonce, ethereumSender, cosmosReceiver, validator, amount)
+			ethBridgeClaim := types.NewEthBridgeClaim(ethereumChainID, bridgeContract, itemID, nonce, ethereumSender, cosmosReceiver, validator, amount, provenance)
 			msg := types.NewMsgMakeEthBridgeClaim(ethBridgeClaim)
 			err = msg.ValidateBasic()
 			if err != nil {
@@ -58,6 +79,301 @@ func GetCmdMakeEthBridgeClaim(cdc *codec.Codec) *cobra.Command {
 
 			cliCtx.PrintResponse = true
 
+			return utils.CompleteAndBroadcastTxCLI(txBldr, cliCtx, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().String(flagEthereumTxHash, "", "hash of the ethereum transaction which made the lock, so that validators can verify the claim")
+	cmd.Flags().Uint64(flagEthereumBlockNumber, 0, "number of the ethereum block holding the lock")
+	cmd.Flags().String(flagEthereumBlockHash, "", "hash of the ethereum block holding the lock")
+	cmd.Flags().Uint64(flagEthereumLogIndex, 0, "index of the lock's log in its ethereum block")
+	return cmd
+}
+
+// ethereumProvenanceFromFlags reads the ethereum log a claim is made from
+func ethereumProvenanceFromFlags(cmd *cobra.Command) (types.EthereumProvenance, error) {
+	flags := cmd.Flags()
+	txHash, err := flags.GetString(flagEthereumTxHash)
+	if err != nil {
+		return types.EthereumProvenance{}, err
+	}
+	blockNumber, err := flags.GetUint64(flagEthereumBlockNumber)
+	if err != nil {
+		return types.EthereumProvenance{}, err
+	}
+	blockHash, err := flags.GetString(flagEthereumBlockHash)
+	if err != nil {
+		return types.EthereumProvenance{}, err
+	}
+	logIndex, err := flags.GetUint64(flagEthereumLogIndex)
+	if err != nil {
+		return types.EthereumProvenance{}, err
+	}
+	return types.NewEthereumProvenance(txHash, blockNumber, blockHash, logIndex), nil
+}
+
+// GetCmdRevokeEthBridgeClaim is the CLI command for revoking a lock which was withdrawn or unlocked on ethereum
+func GetCmdRevokeEthBridgeClaim(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "revoke-claim ethereum-chain-id bridge-contract-address item-id nonce ethereum-sender-address validator-address [withdraw|unlock]",
+		Short: "revoke the ethereum prophecy of a lock released back on ethereum",
+		Args:  cobra.ExactArgs(7),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithAccountDecoder(cdc)
+
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			if err := cliCtx.EnsureAccountExists(); err != nil {
+				return err
+			}
+
+			ethereumChainID, stringError := strconv.Atoi(args[0])
+			if stringError != nil {
+				return stringError
+			}
+
+			bridgeContract := args[1]
+			itemID := args[2]
+			nonce, nonceErr := types.ParseNonce(args[3])
+			if nonceErr != nil {
+				return nonceErr
+			}
+
+			ethereumSender := args[4]
+			validator, err := sdk.AccAddressFromBech32(args[5])
+			if err != nil {
+				return err
+			}
+
+			revocation := types.NewEthBridgeRevocation(ethereumChainID, bridgeContract, itemID, nonce, ethereumSender, validator, args[6])
+			msg := types.NewMsgRevokeEthBridgeClaim(revocation)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			cliCtx.PrintResponse = true
+
+			return utils.CompleteAndBroadcastTxCLI(txBldr, cliCtx, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdMakeBridgeStatusClaim is the CLI command for attesting that locking on the bridge contract was paused or activated
+func GetCmdMakeBridgeStatusClaim(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "make-status-claim ethereum-chain-id [active|paused] ethereum-block validator-address",
+		Short: "attest that locking on the bridge contract was paused or activated at an ethereum block",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithAccountDecoder(cdc)
+
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			if err := cliCtx.EnsureAccountExists(); err != nil {
+				return err
+			}
+
+			ethereumChainID, stringError := strconv.Atoi(args[0])
+			if stringError != nil {
+				return stringError
+			}
+
+			var active bool
+			switch args[1] {
+			case "active":
+				active = true
+			case "paused":
+				active = false
+			default:
+				return fmt.Errorf("bridge status must be active or paused, got %s", args[1])
+			}
+
+			ethereumBlock, stringError := strconv.ParseUint(args[2], 10, 64)
+			if stringError != nil {
+				return stringError
+			}
+
+			validator, err := sdk.AccAddressFromBech32(args[3])
+			if err != nil {
+				return err
+			}
+
+			statusClaim := types.NewBridgeStatusClaim(ethereumChainID, active, ethereumBlock, validator)
+			msg := types.NewMsgMakeBridgeStatusClaim(statusClaim)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			cliCtx.PrintResponse = true
+
+			return utils.CompleteAndBroadcastTxCLI(txBldr, cliCtx, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdSetBridgeStatus is the CLI command for the bridge admin to pause or resume minting
+func GetCmdSetBridgeStatus(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "set-bridge-status [active|paused] admin-address",
+		Short: "pause minting, holding the coins of successful prophecies, or resume it and mint the coins held",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithAccountDecoder(cdc)
+
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			if err := cliCtx.EnsureAccountExists(); err != nil {
+				return err
+			}
+
+			var paused bool
+			switch args[0] {
+			case types.MintingStatusActive:
+				paused = false
+			case types.MintingStatusPaused:
+				paused = true
+			default:
+				return fmt.Errorf("bridge status must be active or paused, got %s", args[0])
+			}
+
+			admin, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetBridgeStatus(paused, admin)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			cliCtx.PrintResponse = true
+
+			return utils.CompleteAndBroadcastTxCLI(txBldr, cliCtx, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdWithdrawRelayerRewards is the CLI command for a validator to withdraw the bridge fees it has earned
+func GetCmdWithdrawRelayerRewards(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "withdraw-relayer-rewards validator-address",
+		Short: "withdraw the bridge fees a validator has earned by relaying claims",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithAccountDecoder(cdc)
+
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			if err := cliCtx.EnsureAccountExists(); err != nil {
+				return err
+			}
+
+			validator, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgWithdrawRelayerRewards(validator)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			cliCtx.PrintResponse = true
+
+			return utils.CompleteAndBroadcastTxCLI(txBldr, cliCtx, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdSetMintLimits is the CLI command for the bridge admin to set the mint limits
+func GetCmdSetMintLimits(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "set-mint-limits window global-limit receiver-limit admin-address",
+		Short: "limit the coins minted over a window of blocks, in all and to each receiver; pass \"\" for no limit",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithAccountDecoder(cdc)
+
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			if err := cliCtx.EnsureAccountExists(); err != nil {
+				return err
+			}
+
+			window, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			globalLimit, err := sdk.ParseCoins(args[1])
+			if err != nil {
+				return err
+			}
+
+			receiverLimit, err := sdk.ParseCoins(args[2])
+			if err != nil {
+				return err
+			}
+
+			admin, err := sdk.AccAddressFromBech32(args[3])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetMintLimits(types.NewMintLimits(window, globalLimit, receiverLimit), admin)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			cliCtx.PrintResponse = true
+
+			return utils.CompleteAndBroadcastTxCLI(txBldr, cliCtx, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdDisputeEscrowedMint is the CLI command for a validator to dispute a mint held in escrow
+func GetCmdDisputeEscrowedMint(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "dispute-escrowed-mint ethereum-chain-id bridge-contract-address nonce validator-address",
+		Short: "dispute a mint held in escrow, which is cancelled once validators with enough power for consensus dispute it",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithAccountDecoder(cdc)
+
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			if err := cliCtx.EnsureAccountExists(); err != nil {
+				return err
+			}
+
+			ethereumChainID, err := strconv.Atoi(args[0])
+			if err != nil {
+				return err
+			}
+
+			nonce, nonceErr := types.ParseNonce(args[2])
+			if nonceErr != nil {
+				return nonceErr
+			}
+
+			validator, err := sdk.AccAddressFromBech32(args[3])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDisputeEscrowedMint(ethereumChainID, args[1], nonce, validator)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			cliCtx.PrintResponse = true
+
 			return utils.CompleteAndBroadcastTxCLI(txBldr, cliCtx, []sdk.Msg{msg})
 		},
 	}
@@ -27,6 +27,21 @@ func (mc ModuleClient) GetQueryCmd() *cobra.Command {
 
 	ethBBridgeQueryCmd.AddCommand(client.GetCommands(
 		ethbridgecmd.GetCmdGetEthBridgeProphecy(mc.queryRoute, mc.cdc),
+		ethbridgecmd.GetCmdGetNonceProphecies(mc.queryRoute, mc.cdc),
+		ethbridgecmd.GetCmdGetNonceGaps(mc.queryRoute, mc.cdc),
+		ethbridgecmd.GetCmdGetBridgedSupply(mc.queryRoute, mc.cdc),
+		ethbridgecmd.GetCmdGetSupply(mc.queryRoute, mc.cdc),
+		ethbridgecmd.GetCmdGetPendingProphecies(mc.queryRoute, mc.cdc),
+		ethbridgecmd.GetCmdGetBridgeStatus(mc.queryRoute, mc.cdc),
+		ethbridgecmd.GetCmdGetMintingStatus(mc.queryRoute, mc.cdc),
+		ethbridgecmd.GetCmdGetPausedMints(mc.queryRoute, mc.cdc),
+		ethbridgecmd.GetCmdGetParams(mc.queryRoute, mc.cdc),
+		ethbridgecmd.GetCmdGetRelayerRewards(mc.queryRoute, mc.cdc),
+		ethbridgecmd.GetCmdGetFeePool(mc.queryRoute, mc.cdc),
+		ethbridgecmd.GetCmdGetMintLimits(mc.queryRoute, mc.cdc),
+		ethbridgecmd.GetCmdGetDelayedMints(mc.queryRoute, mc.cdc),
+		ethbridgecmd.GetCmdGetEscrowedMints(mc.queryRoute, mc.cdc),
+		ethbridgecmd.GetCmdGetRefunds(mc.queryRoute, mc.cdc),
 	)...)
 
 	return ethBBridgeQueryCmd
@@ -41,6 +56,12 @@ func (mc ModuleClient) GetTxCmd() *cobra.Command {
 
 	ethBridgeTxCmd.AddCommand(client.PostCommands(
 		ethbridgecmd.GetCmdMakeEthBridgeClaim(mc.cdc),
+		ethbridgecmd.GetCmdRevokeEthBridgeClaim(mc.cdc),
+		ethbridgecmd.GetCmdMakeBridgeStatusClaim(mc.cdc),
+		ethbridgecmd.GetCmdSetBridgeStatus(mc.cdc),
+		ethbridgecmd.GetCmdWithdrawRelayerRewards(mc.cdc),
+		ethbridgecmd.GetCmdSetMintLimits(mc.cdc),
+		ethbridgecmd.GetCmdDisputeEscrowedMint(mc.cdc),
 	)...)
 
 	return ethBridgeTxCmd
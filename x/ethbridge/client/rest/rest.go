@@ -20,23 +20,52 @@ import (
 )
 
 const (
-	restNonce          = "nonce"
-	restEthereumSender = "ethereumSender"
+	restEthereumChainID = "ethereumChainId"
+	restBridgeContract  = "bridgeContract"
+	restItemID          = "itemId"
+	restNonce           = "nonce"
+	restLimit           = "limit"
+	restValidator       = "validator"
+	restEthereumSender  = "ethereumSender"
 )
 
 // RegisterRoutes - Central function to define routes that get registered by the main application
 func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec, queryRoute string) {
 	r.HandleFunc(fmt.Sprintf("/%s/prophecies", queryRoute), makeClaimHandler(cdc, cliCtx)).Methods("POST")
-	r.HandleFunc(fmt.Sprintf("/%s/prophecies/{%s}/{%s}", queryRoute, restNonce, restEthereumSender), getProphecyHandler(cdc, cliCtx, queryRoute)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/prophecies/{%s}/{%s}/{%s}", queryRoute, restEthereumChainID, restBridgeContract, restItemID), getProphecyHandler(cdc, cliCtx, queryRoute)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/nonce-prophecies/{%s}/{%s}/{%s}", queryRoute, restEthereumChainID, restBridgeContract, restNonce), getNoncePropheciesHandler(cdc, cliCtx, queryRoute)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/nonce-gaps/{%s}/{%s}", queryRoute, restEthereumChainID, restBridgeContract), getNonceGapsHandler(cdc, cliCtx, queryRoute)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/pending-prophecies/{%s}", queryRoute, restEthereumChainID), getPendingPropheciesHandler(cdc, cliCtx, queryRoute)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/status/{%s}", queryRoute, restEthereumChainID), getBridgeStatusHandler(cdc, cliCtx, queryRoute)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/bridged-supply", queryRoute), getBridgedSupplyHandler(cdc, cliCtx, queryRoute)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/supply", queryRoute), getSupplyHandler(cdc, cliCtx, queryRoute)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/params", queryRoute), getParamsHandler(cdc, cliCtx, queryRoute)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/fee-pool", queryRoute), getFeePoolHandler(cdc, cliCtx, queryRoute)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/relayer-rewards/{%s}", queryRoute, restValidator), getRelayerRewardsHandler(cdc, cliCtx, queryRoute)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/relayer-rewards/{%s}", queryRoute, restValidator), withdrawRelayerRewardsHandler(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/minting-status", queryRoute), setBridgeStatusHandler(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/minting-status", queryRoute), getMintingStatusHandler(cdc, cliCtx, queryRoute)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/paused-mints", queryRoute), getPausedMintsHandler(cdc, cliCtx, queryRoute)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/mint-limits", queryRoute), setMintLimitsHandler(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/mint-limits", queryRoute), getMintLimitsHandler(cdc, cliCtx, queryRoute)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/delayed-mints", queryRoute), getDelayedMintsHandler(cdc, cliCtx, queryRoute)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/escrowed-mints", queryRoute), getEscrowedMintsHandler(cdc, cliCtx, queryRoute)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/escrowed-mints/disputes", queryRoute), disputeEscrowedMintHandler(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/refunds/{%s}", queryRoute, restEthereumSender), getRefundsHandler(cdc, cliCtx, queryRoute)).Methods("GET")
 }
 
 type makeEthClaimReq struct {
-	BaseReq        rest.BaseReq `json:"base_req"`
-	Nonce          int          `json:"nonce"`
-	EthereumSender string       `json:"ethereum_sender"`
-	CosmosReceiver string       `json:"cosmos_receiver"`
-	Validator      string       `json:"validator"`
-	Amount         string       `json:"amount"`
+	BaseReq               rest.BaseReq `json:"base_req"`
+	EthereumChainID       int          `json:"ethereum_chain_id"`
+	BridgeContractAddress string       `json:"bridge_contract_address"`
+	ItemID                string       `json:"item_id"`
+	Nonce                 string       `json:"nonce"`
+	EthereumSender        string       `json:"ethereum_sender"`
+	CosmosReceiver        string       `json:"cosmos_receiver"`
+	Validator             string       `json:"validator"`
+	Amount                string       `json:"amount"`
+	// EthereumProvenance is the ethereum log the claim is made from, which may be left empty
+	EthereumProvenance types.EthereumProvenance `json:"ethereum_provenance"`
 }
 
 func makeClaimHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
@@ -53,6 +82,11 @@ func makeClaimHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerF
 			return
 		}
 
+		nonce, nonceErr := types.ParseNonce(req.Nonce)
+		if nonceErr != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, nonceErr.Error())
+			return
+		}
 		ethereumSender := req.EthereumSender
 		cosmosReceiver, err2 := sdk.AccAddressFromBech32(req.CosmosReceiver)
 		if err2 != nil {
@@ -72,7 +106,7 @@ func makeClaimHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerF
 		}
 
 		// create the message
-		ethBridgeClaim := types.NewEthBridgeClaim(req.Nonce, ethereumSender, cosmosReceiver, validator, amount)
+		ethBridgeClaim := types.NewEthBridgeClaim(req.EthereumChainID, req.BridgeContractAddress, req.ItemID, nonce, ethereumSender, cosmosReceiver, validator, amount, req.EthereumProvenance)
 		msg := ethbridge.NewMsgMakeEthBridgeClaim(ethBridgeClaim)
 		err5 := msg.ValidateBasic()
 		if err5 != nil {
@@ -84,19 +118,193 @@ func makeClaimHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerF
 	}
 }
 
+type setBridgeStatusReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+	Paused  bool         `json:"paused"`
+	Admin   string       `json:"admin"`
+}
+
+func setBridgeStatusHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req setBridgeStatusReq
+
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		admin, err := sdk.AccAddressFromBech32(req.Admin)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := ethbridge.NewMsgSetBridgeStatus(req.Paused, admin)
+		err2 := msg.ValidateBasic()
+		if err2 != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err2.Error())
+			return
+		}
+
+		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type setMintLimitsReq struct {
+	BaseReq       rest.BaseReq `json:"base_req"`
+	Window        string       `json:"window"`
+	GlobalLimit   string       `json:"global_limit"`
+	ReceiverLimit string       `json:"receiver_limit"`
+	Admin         string       `json:"admin"`
+}
+
+func setMintLimitsHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req setMintLimitsReq
+
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		window, err := strconv.ParseInt(req.Window, 10, 64)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		globalLimit, err := sdk.ParseCoins(req.GlobalLimit)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		receiverLimit, err := sdk.ParseCoins(req.ReceiverLimit)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		admin, err := sdk.AccAddressFromBech32(req.Admin)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := ethbridge.NewMsgSetMintLimits(ethbridge.NewMintLimits(window, globalLimit, receiverLimit), admin)
+		err2 := msg.ValidateBasic()
+		if err2 != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err2.Error())
+			return
+		}
+
+		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type disputeEscrowedMintReq struct {
+	BaseReq               rest.BaseReq `json:"base_req"`
+	EthereumChainID       int          `json:"ethereum_chain_id"`
+	BridgeContractAddress string       `json:"bridge_contract_address"`
+	Nonce                 string       `json:"nonce"`
+	Validator             string       `json:"validator"`
+}
+
+func disputeEscrowedMintHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req disputeEscrowedMintReq
+
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		nonce, nonceErr := types.ParseNonce(req.Nonce)
+		if nonceErr != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, nonceErr.Error())
+			return
+		}
+
+		validator, err := sdk.AccAddressFromBech32(req.Validator)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := ethbridge.NewMsgDisputeEscrowedMint(req.EthereumChainID, req.BridgeContractAddress, nonce, validator)
+		err2 := msg.ValidateBasic()
+		if err2 != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err2.Error())
+			return
+		}
+
+		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type withdrawRelayerRewardsReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+}
+
+func withdrawRelayerRewardsHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req withdrawRelayerRewardsReq
+
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		validator, err := sdk.AccAddressFromBech32(mux.Vars(r)[restValidator])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := ethbridge.NewMsgWithdrawRelayerRewards(validator)
+		err2 := msg.ValidateBasic()
+		if err2 != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err2.Error())
+			return
+		}
+
+		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
 func getProphecyHandler(cdc *codec.Codec, cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		vars := mux.Vars(r)
-		nonce := vars[restNonce]
 
-		nonceString, err := strconv.Atoi(nonce)
+		ethereumChainID, err := strconv.Atoi(vars[restEthereumChainID])
 		if err != nil {
-			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
-		ethereumSender := vars[restEthereumSender]
 
-		bz, err := cdc.MarshalJSON(ethbridge.NewQueryEthProphecyParams(nonceString, ethereumSender))
+		prophecyID := ethbridge.NewProphecyID(ethereumChainID, vars[restBridgeContract], vars[restItemID])
+
+		bz, err := cdc.MarshalJSON(ethbridge.NewQueryEthProphecyParams(prophecyID))
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
 			return
@@ -112,3 +320,289 @@ func getProphecyHandler(cdc *codec.Codec, cliCtx context.CLIContext, queryRoute
 		rest.PostProcessResponse(w, cdc, res, cliCtx.Indent)
 	}
 }
+
+func getNoncePropheciesHandler(cdc *codec.Codec, cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		ethereumChainID, err := strconv.Atoi(vars[restEthereumChainID])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		nonce, nonceErr := types.ParseNonce(vars[restNonce])
+		if nonceErr != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, nonceErr.Error())
+			return
+		}
+
+		bz, err := cdc.MarshalJSON(ethbridge.NewQueryNoncePropheciesParams(ethereumChainID, vars[restBridgeContract], nonce))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", queryRoute, querier.QueryNonceProphecies)
+		res, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+
+// getNonceGapsHandler serves the nonce gaps of a bridge contract, listing as many as the optional limit query
+// parameter allows
+func getNonceGapsHandler(cdc *codec.Codec, cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		ethereumChainID, err := strconv.Atoi(vars[restEthereumChainID])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		limit := types.DefaultNonceGapsLimit
+		if limitParam := r.URL.Query().Get(restLimit); limitParam != "" {
+			limit, err = strconv.Atoi(limitParam)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+
+		bz, err := cdc.MarshalJSON(ethbridge.NewQueryNonceGapsParams(ethereumChainID, vars[restBridgeContract], limit))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", queryRoute, querier.QueryNonceGaps)
+		res, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+
+func getPendingPropheciesHandler(cdc *codec.Codec, cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		ethereumChainID, err := strconv.Atoi(vars[restEthereumChainID])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		bz, err := cdc.MarshalJSON(ethbridge.NewQueryPendingPropheciesParams(ethereumChainID))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", queryRoute, querier.QueryPendingProphecies)
+		res, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+
+func getBridgeStatusHandler(cdc *codec.Codec, cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		ethereumChainID, err := strconv.Atoi(vars[restEthereumChainID])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		bz, err := cdc.MarshalJSON(ethbridge.NewQueryBridgeStatusParams(ethereumChainID))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", queryRoute, querier.QueryBridgeStatus)
+		res, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+
+func getBridgedSupplyHandler(cdc *codec.Codec, cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route := fmt.Sprintf("custom/%s/%s", queryRoute, querier.QueryBridgedSupply)
+		res, err := cliCtx.QueryWithData(route, nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+
+func getMintingStatusHandler(cdc *codec.Codec, cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route := fmt.Sprintf("custom/%s/%s", queryRoute, querier.QueryMintingStatus)
+		res, err := cliCtx.QueryWithData(route, nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+
+func getPausedMintsHandler(cdc *codec.Codec, cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route := fmt.Sprintf("custom/%s/%s", queryRoute, querier.QueryPausedMints)
+		res, err := cliCtx.QueryWithData(route, nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+
+func getSupplyHandler(cdc *codec.Codec, cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route := fmt.Sprintf("custom/%s/%s", queryRoute, querier.QuerySupply)
+		res, err := cliCtx.QueryWithData(route, nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+
+func getParamsHandler(cdc *codec.Codec, cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route := fmt.Sprintf("custom/%s/%s", queryRoute, querier.QueryParams)
+		res, err := cliCtx.QueryWithData(route, nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+
+func getFeePoolHandler(cdc *codec.Codec, cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route := fmt.Sprintf("custom/%s/%s", queryRoute, querier.QueryFeePool)
+		res, err := cliCtx.QueryWithData(route, nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+
+func getRelayerRewardsHandler(cdc *codec.Codec, cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		validator, err := sdk.AccAddressFromBech32(mux.Vars(r)[restValidator])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		bz, err := cdc.MarshalJSON(ethbridge.NewQueryRelayerRewardsParams(validator))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", queryRoute, querier.QueryRelayerRewards)
+		res, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+
+func getMintLimitsHandler(cdc *codec.Codec, cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route := fmt.Sprintf("custom/%s/%s", queryRoute, querier.QueryMintLimits)
+		res, err := cliCtx.QueryWithData(route, nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+
+func getDelayedMintsHandler(cdc *codec.Codec, cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route := fmt.Sprintf("custom/%s/%s", queryRoute, querier.QueryDelayedMints)
+		res, err := cliCtx.QueryWithData(route, nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+
+func getEscrowedMintsHandler(cdc *codec.Codec, cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route := fmt.Sprintf("custom/%s/%s", queryRoute, querier.QueryEscrowedMints)
+		res, err := cliCtx.QueryWithData(route, nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+
+func getRefundsHandler(cdc *codec.Codec, cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		bz, err := cdc.MarshalJSON(ethbridge.NewQueryRefundsParams(mux.Vars(r)[restEthereumSender]))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", queryRoute, querier.QueryRefunds)
+		res, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
@@ -1,8 +1,22 @@
 package common
 
-import gethCommon "github.com/ethereum/go-ethereum/common"
+import (
+	"encoding/hex"
+	"strings"
+
+	gethCommon "github.com/ethereum/go-ethereum/common"
+)
 
 //IsValidEthereumAddress returns true if address is valid
 func IsValidEthAddress(s string) bool {
 	return gethCommon.IsHexAddress(s)
 }
+
+// IsValidEthHash returns true if s is a 0x-prefixed, hex-encoded 32 byte hash, such as a transaction hash
+func IsValidEthHash(s string) bool {
+	if !strings.HasPrefix(s, "0x") || len(s) != 2+2*gethCommon.HashLength {
+		return false
+	}
+	_, err := hex.DecodeString(s[2:])
+	return err == nil
+}
@@ -0,0 +1,91 @@
+package ethbridge
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/swishlabsco/cosmos-ethereum-bridge/x/ethbridge/keeper"
+	"github.com/swishlabsco/cosmos-ethereum-bridge/x/ethbridge/types"
+	"github.com/swishlabsco/cosmos-ethereum-bridge/x/oracle"
+)
+
+// EndBlocker forgets the mints which have left the mint limit window, then releases the escrowed mints which have
+// reached their release height without being cancelled, and mints the delayed mints which now fit in the mint
+// limits, in order of their nonces within each bridge contract. Nothing is released while minting is paused. A
+// release which fails is logged and left for a later block, so that it cannot halt the chain.
+func EndBlocker(ctx sdk.Context, bridgeKeeper keeper.Keeper, oracleKeeper oracle.Keeper, bankKeeper bank.Keeper) sdk.Tags {
+	bridgeKeeper.PruneWindowMints(ctx)
+	tags := sdk.EmptyTags()
+	if bridgeKeeper.IsMintingPaused(ctx) {
+		return tags
+	}
+	var escrowedMints []types.EscrowedMint
+	bridgeKeeper.IterateEscrowedMints(ctx, func(escrowedMint types.EscrowedMint) bool {
+		if escrowedMint.ReleaseHeight <= ctx.BlockHeight() {
+			escrowedMints = append(escrowedMints, escrowedMint)
+		}
+		return false
+	})
+	for _, escrowedMint := range escrowedMints {
+		mintTags, err := releaseEscrowedMint(ctx, bridgeKeeper, oracleKeeper, bankKeeper, escrowedMint)
+		if err != nil {
+			ctx.Logger().Error(fmt.Sprintf("could not release escrowed mint of prophecy %s: %s", escrowedMint.NonceProphecy.OracleID, err))
+			continue
+		}
+		tags = tags.AppendTags(mintTags)
+	}
+	var delayedMints []types.DelayedMint
+	bridgeKeeper.IterateDelayedMints(ctx, func(delayedMint types.DelayedMint) bool {
+		delayedMints = append(delayedMints, delayedMint)
+		return false
+	})
+	for _, delayedMint := range delayedMints {
+		mintTags, err := releaseDelayedMint(ctx, bridgeKeeper, oracleKeeper, bankKeeper, delayedMint)
+		if err != nil {
+			ctx.Logger().Error(fmt.Sprintf("could not release delayed mint of prophecy %s: %s", delayedMint.NonceProphecy.OracleID, err))
+			continue
+		}
+		tags = tags.AppendTags(mintTags)
+	}
+	return tags
+}
+
+// releaseEscrowedMint mints the coins of an escrowed mint, or delays them if they would go over the mint limits,
+// writing its state changes only if it succeeds
+func releaseEscrowedMint(ctx sdk.Context, bridgeKeeper keeper.Keeper, oracleKeeper oracle.Keeper, bankKeeper bank.Keeper, escrowedMint types.EscrowedMint) (sdk.Tags, sdk.Error) {
+	prophecy, oracleClaim, err := getFinalClaim(ctx, oracleKeeper, escrowedMint.NonceProphecy)
+	if err != nil {
+		return nil, err
+	}
+	cacheCtx, writeCache := ctx.CacheContext()
+	tags, err := mintOrDelay(cacheCtx, bridgeKeeper, oracleKeeper, bankKeeper, escrowedMint.NonceProphecy, prophecy, oracleClaim)
+	if err != nil {
+		return nil, err
+	}
+	id := escrowedMint.NonceProphecy.ProphecyID
+	bridgeKeeper.DeleteEscrowedMint(cacheCtx, id.EthereumChainID, id.BridgeContractAddress, escrowedMint.NonceProphecy.Nonce)
+	writeCache()
+	return tags, nil
+}
+
+// releaseDelayedMint mints the coins of a delayed mint if they now fit in the mint limits, writing its state
+// changes only if it succeeds
+func releaseDelayedMint(ctx sdk.Context, bridgeKeeper keeper.Keeper, oracleKeeper oracle.Keeper, bankKeeper bank.Keeper, delayedMint types.DelayedMint) (sdk.Tags, sdk.Error) {
+	prophecy, oracleClaim, err := getFinalClaim(ctx, oracleKeeper, delayedMint.NonceProphecy)
+	if err != nil {
+		return nil, err
+	}
+	if !bridgeKeeper.CanReleaseDelayedMint(ctx, delayedMint, oracleClaim.CosmosReceiver, oracleClaim.Amount) {
+		return nil, nil
+	}
+	cacheCtx, writeCache := ctx.CacheContext()
+	tags, err := processSuccessfulClaim(cacheCtx, bridgeKeeper, oracleKeeper, bankKeeper, prophecy)
+	if err != nil {
+		return nil, err
+	}
+	id := delayedMint.NonceProphecy.ProphecyID
+	bridgeKeeper.DeleteDelayedMint(cacheCtx, id.EthereumChainID, id.BridgeContractAddress, delayedMint.NonceProphecy.Nonce)
+	writeCache()
+	return tags, nil
+}
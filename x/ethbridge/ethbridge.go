@@ -1,25 +1,74 @@
 package ethbridge
 
 import (
+	"github.com/swishlabsco/cosmos-ethereum-bridge/x/ethbridge/keeper"
 	"github.com/swishlabsco/cosmos-ethereum-bridge/x/ethbridge/querier"
 	"github.com/swishlabsco/cosmos-ethereum-bridge/x/ethbridge/types"
 )
 
 type (
-	MsgMakeEthBridgeClaim = types.MsgMakeEthBridgeClaim
+	Keeper = keeper.Keeper
+
+	MsgMakeEthBridgeClaim       = types.MsgMakeEthBridgeClaim
+	MsgRevokeEthBridgeClaim     = types.MsgRevokeEthBridgeClaim
+	MsgMakeBridgeStatusClaim    = types.MsgMakeBridgeStatusClaim
+	MsgSetBridgeStatus          = types.MsgSetBridgeStatus
+	MsgWithdrawRelayerRewards   = types.MsgWithdrawRelayerRewards
+	MsgSetMintLimits            = types.MsgSetMintLimits
+	MsgDisputeEscrowedMint      = types.MsgDisputeEscrowedMint
+	MsgMakeInvalidReceiverClaim = types.MsgMakeInvalidReceiverClaim
+
+	BridgeStatus = types.BridgeStatus
+	MintLimits   = types.MintLimits
+	Refund       = types.Refund
+
+	GenesisState = types.GenesisState
+	Params       = types.Params
+
+	ProphecyID = types.ProphecyID
 )
 
 var (
-	NewMsgMakeEthBridgeClaim = types.NewMsgMakeEthBridgeClaim
-	NewEthBridgeClaim        = types.NewEthBridgeClaim
+	NewKeeper = keeper.NewKeeper
+
+	NewMsgMakeEthBridgeClaim       = types.NewMsgMakeEthBridgeClaim
+	NewEthBridgeClaim              = types.NewEthBridgeClaim
+	NewMsgRevokeEthBridgeClaim     = types.NewMsgRevokeEthBridgeClaim
+	NewEthBridgeRevocation         = types.NewEthBridgeRevocation
+	NewMsgMakeBridgeStatusClaim    = types.NewMsgMakeBridgeStatusClaim
+	NewBridgeStatusClaim           = types.NewBridgeStatusClaim
+	NewMsgSetBridgeStatus          = types.NewMsgSetBridgeStatus
+	NewMsgWithdrawRelayerRewards   = types.NewMsgWithdrawRelayerRewards
+	NewMsgSetMintLimits            = types.NewMsgSetMintLimits
+	NewMintLimits                  = types.NewMintLimits
+	NewMsgDisputeEscrowedMint      = types.NewMsgDisputeEscrowedMint
+	NewMsgMakeInvalidReceiverClaim = types.NewMsgMakeInvalidReceiverClaim
+	NewInvalidReceiverClaim        = types.NewInvalidReceiverClaim
+	NewProphecyID                  = types.NewProphecyID
 
-	NewQueryEthProphecyParams = types.NewQueryEthProphecyParams
+	NewQueryEthProphecyParams       = types.NewQueryEthProphecyParams
+	NewQueryNoncePropheciesParams   = types.NewQueryNoncePropheciesParams
+	NewQueryNonceGapsParams         = types.NewQueryNonceGapsParams
+	NewQueryPendingPropheciesParams = types.NewQueryPendingPropheciesParams
+	NewQueryBridgeStatusParams      = types.NewQueryBridgeStatusParams
+	NewQueryRelayerRewardsParams    = types.NewQueryRelayerRewardsParams
+	NewQueryRefundsParams           = types.NewQueryRefundsParams
 
 	ErrInvalidEthNonce = types.ErrInvalidEthNonce
 
+	NewGenesisState     = types.NewGenesisState
+	DefaultGenesisState = types.DefaultGenesisState
+	NewParams           = types.NewParams
+	DefaultParams       = types.DefaultParams
+
 	RegisterCodec = types.RegisterCodec
 
 	NewQuerier = querier.NewQuerier
+
+	ModuleAddress = types.ModuleAddress
+
+	SupplyInvariant         = keeper.SupplyInvariant
+	RelayerRewardsInvariant = keeper.RelayerRewardsInvariant
 )
 
 const (
@@ -28,5 +77,22 @@ const (
 	RouterKey        = types.RouterKey
 	DefaultCodespace = types.DefaultCodespace
 
-	QueryEthProphecy = querier.QueryEthProphecy
+	DefaultParamspace = types.DefaultParamspace
+
+	QueryEthProphecy       = querier.QueryEthProphecy
+	QueryNonceProphecies   = querier.QueryNonceProphecies
+	QueryNonceGaps         = querier.QueryNonceGaps
+	QueryPendingProphecies = querier.QueryPendingProphecies
+	QueryBridgeStatus      = querier.QueryBridgeStatus
+	QueryBridgedSupply     = querier.QueryBridgedSupply
+	QueryMintingStatus     = querier.QueryMintingStatus
+	QueryPausedMints       = querier.QueryPausedMints
+	QuerySupply            = querier.QuerySupply
+	QueryParams            = querier.QueryParams
+	QueryRelayerRewards    = querier.QueryRelayerRewards
+	QueryFeePool           = querier.QueryFeePool
+	QueryMintLimits        = querier.QueryMintLimits
+	QueryDelayedMints      = querier.QueryDelayedMints
+	QueryEscrowedMints     = querier.QueryEscrowedMints
+	QueryRefunds           = querier.QueryRefunds
 )
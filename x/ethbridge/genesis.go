@@ -0,0 +1,113 @@
+package ethbridge
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/swishlabsco/cosmos-ethereum-bridge/x/ethbridge/keeper"
+	"github.com/swishlabsco/cosmos-ethereum-bridge/x/ethbridge/types"
+)
+
+// InitGenesis sets the ethbridge params, supply records, minting status, held mints, refunds and relayer rewards
+// from the genesis state
+func InitGenesis(ctx sdk.Context, bridgeKeeper keeper.Keeper, data types.GenesisState) {
+	bridgeKeeper.SetParams(ctx, data.Params)
+	bridgeKeeper.SetBridgedSupply(ctx, data.BridgedSupply)
+	bridgeKeeper.SetSupplyTracked(ctx)
+	bridgeKeeper.SetMinted(ctx, data.Minted)
+	bridgeKeeper.SetBurned(ctx, data.Burned)
+	bridgeKeeper.SetMintingPaused(ctx, data.MintingPaused)
+	for _, pausedMint := range data.PausedMints {
+		bridgeKeeper.SetPausedMint(ctx, pausedMint)
+	}
+	for _, queuedMint := range data.QueuedMints {
+		bridgeKeeper.SetQueuedMint(ctx, queuedMint)
+	}
+	for _, nonce := range data.LastFinalizedNonces {
+		bridgeKeeper.SetLastFinalizedNonce(ctx, nonce.EthereumChainID, nonce.BridgeContractAddress, nonce.Nonce)
+	}
+	for _, delayedMint := range data.DelayedMints {
+		bridgeKeeper.SetDelayedMint(ctx, delayedMint)
+	}
+	for _, windowMint := range data.WindowMints {
+		bridgeKeeper.SetWindowMint(ctx, windowMint)
+	}
+	for _, escrowedMint := range data.EscrowedMints {
+		bridgeKeeper.SetEscrowedMint(ctx, escrowedMint)
+	}
+	for _, refund := range data.Refunds {
+		bridgeKeeper.SetRefund(ctx, refund)
+	}
+	for _, reward := range data.RelayerRewards {
+		bridgeKeeper.SetRelayerRewards(ctx, reward)
+	}
+	bridgeKeeper.SetUndistributedFees(ctx, data.UndistributedFees)
+	for _, mintFee := range data.MintFees {
+		bridgeKeeper.SetMintFee(ctx, mintFee.OracleID, mintFee.Fee)
+	}
+}
+
+// ExportGenesis returns the ethbridge genesis state of the chain's current state
+func ExportGenesis(ctx sdk.Context, bridgeKeeper keeper.Keeper) types.GenesisState {
+	pausedMints := []types.NonceProphecy{}
+	bridgeKeeper.IteratePausedMints(ctx, func(pausedMint types.NonceProphecy) bool {
+		pausedMints = append(pausedMints, pausedMint)
+		return false
+	})
+	queuedMints := []types.NonceProphecy{}
+	bridgeKeeper.IterateAllQueuedMints(ctx, func(queuedMint types.NonceProphecy) bool {
+		queuedMints = append(queuedMints, queuedMint)
+		return false
+	})
+	lastFinalizedNonces := []types.LastFinalizedNonce{}
+	bridgeKeeper.IterateLastFinalizedNonces(ctx, func(nonce types.LastFinalizedNonce) bool {
+		lastFinalizedNonces = append(lastFinalizedNonces, nonce)
+		return false
+	})
+	delayedMints := []types.DelayedMint{}
+	bridgeKeeper.IterateDelayedMints(ctx, func(delayedMint types.DelayedMint) bool {
+		delayedMints = append(delayedMints, delayedMint)
+		return false
+	})
+	windowMints := []types.WindowMint{}
+	bridgeKeeper.IterateWindowMints(ctx, func(windowMint types.WindowMint) bool {
+		windowMints = append(windowMints, windowMint)
+		return false
+	})
+	escrowedMints := []types.EscrowedMint{}
+	bridgeKeeper.IterateEscrowedMints(ctx, func(escrowedMint types.EscrowedMint) bool {
+		escrowedMints = append(escrowedMints, escrowedMint)
+		return false
+	})
+	refunds := []types.Refund{}
+	bridgeKeeper.IterateRefunds(ctx, func(refund types.Refund) bool {
+		refunds = append(refunds, refund)
+		return false
+	})
+	relayerRewards := []types.RelayerReward{}
+	bridgeKeeper.IterateRelayerRewards(ctx, func(reward types.RelayerReward) bool {
+		relayerRewards = append(relayerRewards, reward)
+		return false
+	})
+	mintFees := []types.MintFee{}
+	bridgeKeeper.IterateMintFees(ctx, func(mintFee types.MintFee) bool {
+		mintFees = append(mintFees, mintFee)
+		return false
+	})
+	return types.NewGenesisState(
+		bridgeKeeper.GetParams(ctx),
+		bridgeKeeper.GetBridgedSupply(ctx),
+		bridgeKeeper.GetMinted(ctx),
+		bridgeKeeper.GetBurned(ctx),
+		bridgeKeeper.IsMintingPaused(ctx),
+		pausedMints,
+		queuedMints,
+		lastFinalizedNonces,
+		delayedMints,
+		windowMints,
+		escrowedMints,
+		refunds,
+		relayerRewards,
+		bridgeKeeper.GetUndistributedFees(ctx),
+		mintFees,
+	)
+}
@@ -0,0 +1,78 @@
+package ethbridge
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	bridgeKeeperLib "github.com/swishlabsco/cosmos-ethereum-bridge/x/ethbridge/keeper"
+	"github.com/swishlabsco/cosmos-ethereum-bridge/x/ethbridge/types"
+)
+
+func TestExportImportGenesis(t *testing.T) {
+	ctx, bridgeKeeper, _, _, validatorAddresses := bridgeKeeperLib.CreateTestKeepers(t, 0.7, []int64{3, 7})
+	receiverAddress, err := sdk.AccAddressFromBech32(types.TestAddress)
+	require.NoError(t, err)
+	coins := sdk.Coins{sdk.NewInt64Coin("ethereum", 10)}
+	nonceProphecy := func(nonce uint64) types.NonceProphecy {
+		prophecyID := types.NewProphecyID(types.TestEthereumChainID, types.TestBridgeContract, types.TestEthereumItemID)
+		return types.NewNonceProphecy(sdk.NewUint(nonce), prophecyID, string(prophecyID.Key()))
+	}
+
+	//A chain with minting paused, mints held in every way, refunds and relayer rewards
+	InitGenesis(ctx, bridgeKeeper, types.DefaultGenesisState())
+	bridgeKeeper.AddBridgedSupply(ctx, coins)
+	bridgeKeeper.SetMinted(ctx, coins)
+	bridgeKeeper.SetBurned(ctx, sdk.Coins{sdk.NewInt64Coin("ethereum", 1)})
+	bridgeKeeper.SetMintingPaused(ctx, true)
+	bridgeKeeper.SetPausedMint(ctx, nonceProphecy(2))
+	bridgeKeeper.SetQueuedMint(ctx, nonceProphecy(4))
+	bridgeKeeper.SetLastFinalizedNonce(ctx, types.TestEthereumChainID, types.TestBridgeContract, sdk.NewUint(2))
+	bridgeKeeper.SetDelayedMint(ctx, types.NewDelayedMint(nonceProphecy(5), 7))
+	bridgeKeeper.SetWindowMint(ctx, types.NewWindowMint(8, receiverAddress, coins))
+	escrowedMint := types.NewEscrowedMint(nonceProphecy(6), 20)
+	escrowedMint.Disputes = append(escrowedMint.Disputes, validatorAddresses[0])
+	bridgeKeeper.SetEscrowedMint(ctx, escrowedMint)
+	prophecyID := types.NewProphecyID(types.TestEthereumChainID, types.TestBridgeContract, types.AltTestEthereumItemID)
+	bridgeKeeper.SetRefund(ctx, types.NewRefund(prophecyID, types.OracleClaim{EthereumSender: types.TestEthereumAddress,
+		Nonce: sdk.NewUint(3), InvalidReceiver: types.TestInvalidReceiver, Amount: coins}))
+	bridgeKeeper.SetRelayerRewards(ctx, types.NewRelayerReward(sdk.AccAddress(validatorAddresses[1]), coins))
+	bridgeKeeper.SetUndistributedFees(ctx, sdk.Coins{sdk.NewInt64Coin("ethereum", 3)})
+	bridgeKeeper.SetMintFee(ctx, nonceProphecy(1).OracleID, sdk.Coins{sdk.NewInt64Coin("ethereum", 2)})
+
+	exported := ExportGenesis(ctx, bridgeKeeper)
+	require.Len(t, exported.PausedMints, 1)
+	require.Len(t, exported.QueuedMints, 1)
+	require.Len(t, exported.LastFinalizedNonces, 1)
+	require.Len(t, exported.DelayedMints, 1)
+	require.Len(t, exported.WindowMints, 1)
+	require.Len(t, exported.EscrowedMints, 1)
+	require.Len(t, exported.Refunds, 1)
+	require.Len(t, exported.RelayerRewards, 1)
+	require.Len(t, exported.MintFees, 1)
+
+	//A new chain started from the exported state has the same state
+	newCtx, newBridgeKeeper, _, _, _ := bridgeKeeperLib.CreateTestKeepers(t, 0.7, []int64{3, 7})
+	InitGenesis(newCtx, newBridgeKeeper, exported)
+	require.Equal(t, exported, ExportGenesis(newCtx, newBridgeKeeper))
+
+	require.True(t, newBridgeKeeper.IsMintingPaused(newCtx))
+	_, ok := newBridgeKeeper.GetPausedMint(newCtx, types.TestEthereumChainID, types.TestBridgeContract, sdk.NewUint(2))
+	require.True(t, ok)
+	_, ok = newBridgeKeeper.GetQueuedMint(newCtx, types.TestEthereumChainID, types.TestBridgeContract, sdk.NewUint(4))
+	require.True(t, ok)
+	require.Equal(t, sdk.NewUint(2), newBridgeKeeper.GetLastFinalizedNonce(newCtx, types.TestEthereumChainID, types.TestBridgeContract))
+	delayedMint, ok := newBridgeKeeper.GetDelayedMint(newCtx, types.TestEthereumChainID, types.TestBridgeContract, sdk.NewUint(5))
+	require.True(t, ok)
+	require.Equal(t, int64(7), delayedMint.DelayedAt)
+	importedEscrowedMint, ok := newBridgeKeeper.GetEscrowedMint(newCtx, types.TestEthereumChainID, types.TestBridgeContract, sdk.NewUint(6))
+	require.True(t, ok)
+	require.True(t, importedEscrowedMint.HasDisputed(validatorAddresses[0]))
+	refunds := newBridgeKeeper.GetRefunds(newCtx, types.TestEthereumAddress)
+	require.Len(t, refunds, 1)
+	require.Equal(t, types.RefundStatusRefundable, refunds[0].Status)
+	require.Equal(t, "10ethereum", newBridgeKeeper.GetRelayerRewards(newCtx, sdk.AccAddress(validatorAddresses[1])).String())
+	require.Equal(t, "3ethereum", newBridgeKeeper.GetUndistributedFees(newCtx).String())
+	require.Equal(t, "2ethereum", newBridgeKeeper.GetMintFee(newCtx, nonceProphecy(1).OracleID).String())
+}
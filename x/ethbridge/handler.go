@@ -7,16 +7,31 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	"github.com/swishlabsco/cosmos-ethereum-bridge/x/ethbridge/common"
+	"github.com/swishlabsco/cosmos-ethereum-bridge/x/ethbridge/keeper"
 	"github.com/swishlabsco/cosmos-ethereum-bridge/x/ethbridge/types"
 	"github.com/swishlabsco/cosmos-ethereum-bridge/x/oracle"
 )
 
 // NewHandler returns a handler for "ethbridge" type messages.
-func NewHandler(oracleKeeper oracle.Keeper, bankKeeper bank.Keeper, cdc *codec.Codec, codespace sdk.CodespaceType) sdk.Handler {
+func NewHandler(bridgeKeeper keeper.Keeper, oracleKeeper oracle.Keeper, bankKeeper bank.Keeper, cdc *codec.Codec, codespace sdk.CodespaceType) sdk.Handler {
 	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
 		switch msg := msg.(type) {
 		case MsgMakeEthBridgeClaim:
-			return handleMsgMakeEthBridgeClaim(ctx, cdc, oracleKeeper, bankKeeper, msg, codespace)
+			return handleMsgMakeEthBridgeClaim(ctx, cdc, bridgeKeeper, oracleKeeper, bankKeeper, msg, codespace)
+		case MsgMakeInvalidReceiverClaim:
+			return handleMsgMakeInvalidReceiverClaim(ctx, cdc, bridgeKeeper, oracleKeeper, bankKeeper, msg, codespace)
+		case MsgRevokeEthBridgeClaim:
+			return handleMsgRevokeEthBridgeClaim(ctx, cdc, bridgeKeeper, oracleKeeper, bankKeeper, msg, codespace)
+		case MsgMakeBridgeStatusClaim:
+			return handleMsgMakeBridgeStatusClaim(ctx, cdc, bridgeKeeper, oracleKeeper, msg, codespace)
+		case MsgSetBridgeStatus:
+			return handleMsgSetBridgeStatus(ctx, bridgeKeeper, oracleKeeper, bankKeeper, msg, codespace)
+		case MsgWithdrawRelayerRewards:
+			return handleMsgWithdrawRelayerRewards(ctx, bridgeKeeper, bankKeeper, msg)
+		case MsgSetMintLimits:
+			return handleMsgSetMintLimits(ctx, bridgeKeeper, msg, codespace)
+		case MsgDisputeEscrowedMint:
+			return handleMsgDisputeEscrowedMint(ctx, bridgeKeeper, oracleKeeper, msg, codespace)
 		default:
 			errMsg := fmt.Sprintf("Unrecognized ethbridge message type: %v", msg.Type())
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -25,39 +40,443 @@ func NewHandler(oracleKeeper oracle.Keeper, bankKeeper bank.Keeper, cdc *codec.C
 }
 
 // Handle a message to make a bridge claim
-func handleMsgMakeEthBridgeClaim(ctx sdk.Context, cdc *codec.Codec, oracleKeeper oracle.Keeper, bankKeeper bank.Keeper, msg MsgMakeEthBridgeClaim, codespace sdk.CodespaceType) sdk.Result {
+func handleMsgMakeEthBridgeClaim(ctx sdk.Context, cdc *codec.Codec, bridgeKeeper keeper.Keeper, oracleKeeper oracle.Keeper, bankKeeper bank.Keeper, msg MsgMakeEthBridgeClaim, codespace sdk.CodespaceType) sdk.Result {
 	if msg.CosmosReceiver.Empty() {
 		return sdk.ErrInvalidAddress(msg.CosmosReceiver.String()).Result()
 	}
-	if msg.Nonce < 0 {
+	if msg.InvalidReceiver != "" {
+		return types.ErrInvalidReceiverClaim(codespace).Result()
+	}
+	return processLockClaim(ctx, cdc, bridgeKeeper, oracleKeeper, bankKeeper, msg.EthBridgeClaim, codespace)
+}
+
+// Handle a message to claim a lock whose recipient is not a valid cosmos address. It is made on the same prophecy as
+// the lock's other claims, so the lock is either minted or made refundable to its ethereum sender.
+func handleMsgMakeInvalidReceiverClaim(ctx sdk.Context, cdc *codec.Codec, bridgeKeeper keeper.Keeper, oracleKeeper oracle.Keeper, bankKeeper bank.Keeper, msg MsgMakeInvalidReceiverClaim, codespace sdk.CodespaceType) sdk.Result {
+	if !msg.CosmosReceiver.Empty() || msg.InvalidReceiver == "" {
+		return types.ErrInvalidReceiverClaim(codespace).Result()
+	}
+	return processLockClaim(ctx, cdc, bridgeKeeper, oracleKeeper, bankKeeper, msg.EthBridgeClaim, codespace)
+}
+
+// processLockClaim makes a claim on the prophecy of a lock. If the prophecy succeeds on a claim to a valid receiver
+// its coins are minted, and if it succeeds on a claim to an invalid receiver the lock is recorded as refundable.
+func processLockClaim(ctx sdk.Context, cdc *codec.Codec, bridgeKeeper keeper.Keeper, oracleKeeper oracle.Keeper, bankKeeper bank.Keeper, claim types.EthBridgeClaim, codespace sdk.CodespaceType) sdk.Result {
+	if claim.EthereumChainID <= 0 {
+		return types.ErrInvalidChainID(codespace).Result()
+	}
+	if !types.IsValidNonce(claim.Nonce) {
+		return types.ErrInvalidEthNonce(codespace).Result()
+	}
+	if !common.IsValidEthAddress(claim.BridgeContractAddress) {
+		return types.ErrInvalidEthAddress(codespace).Result()
+	}
+	if !common.IsValidEthHash(claim.ItemID) {
+		return types.ErrInvalidEthItemID(codespace).Result()
+	}
+	if !common.IsValidEthAddress(claim.EthereumSender) {
+		return types.ErrInvalidEthAddress(codespace).Result()
+	}
+	if err := claim.EthereumProvenance.ValidateBasic(codespace); err != nil {
+		return err.Result()
+	}
+	oracleId, validator, claimString := types.CreateOracleClaimFromEthClaim(cdc, claim)
+	nonceProphecies := bridgeKeeper.GetNonceProphecies(ctx, claim.EthereumChainID, claim.BridgeContractAddress, claim.Nonce)
+	if isNonceMinted(ctx, oracleKeeper, nonceProphecies, claim.Nonce, oracleId) {
+		return types.ErrNonceAlreadyMinted(codespace).Result()
+	}
+	status, err := oracleKeeper.ProcessClaim(ctx, oracleId, validator, claimString)
+	if err != nil {
+		return err.Result()
+	}
+	nonceProphecy := types.NewNonceProphecy(claim.Nonce, claim.ProphecyID(), oracleId)
+	bridgeKeeper.SetNonceProphecy(ctx, nonceProphecy)
+	tags := sdk.EmptyTags()
+	if status.StatusText == oracle.SuccessStatus {
+		finalClaim, err := types.CreateOracleClaimFromOracleString(status.FinalClaim)
+		if err != nil {
+			return err.Result()
+		}
+		if finalClaim.IsInvalidReceiver() {
+			bridgeKeeper.SetRefund(ctx, types.NewRefund(nonceProphecy.ProphecyID, finalClaim))
+		} else if isMintQueued(ctx, bridgeKeeper, nonceProphecy) {
+			bridgeKeeper.SetQueuedMint(ctx, nonceProphecy)
+		} else {
+			mintTags, err := mintOrHold(ctx, bridgeKeeper, oracleKeeper, bankKeeper, nonceProphecy)
+			if err != nil {
+				return err.Result()
+			}
+			tags = tags.AppendTags(mintTags)
+		}
+	}
+	if status.StatusText != oracle.PendingStatus {
+		finalizeTags, err := finalizeNonces(ctx, bridgeKeeper, oracleKeeper, bankKeeper, claim.EthereumChainID, claim.BridgeContractAddress)
+		if err != nil {
+			return err.Result()
+		}
+		tags = tags.AppendTags(finalizeTags)
+	}
+	return sdk.Result{Log: status.StatusText, Tags: tags}
+}
+
+// Handle a message to revoke a lock which was withdrawn or unlocked on ethereum
+func handleMsgRevokeEthBridgeClaim(ctx sdk.Context, cdc *codec.Codec, bridgeKeeper keeper.Keeper, oracleKeeper oracle.Keeper, bankKeeper bank.Keeper, msg MsgRevokeEthBridgeClaim, codespace sdk.CodespaceType) sdk.Result {
+	if msg.EthereumChainID <= 0 {
+		return types.ErrInvalidChainID(codespace).Result()
+	}
+	if !types.IsValidNonce(msg.Nonce) {
 		return types.ErrInvalidEthNonce(codespace).Result()
 	}
+	if !common.IsValidEthAddress(msg.BridgeContractAddress) {
+		return types.ErrInvalidEthAddress(codespace).Result()
+	}
+	if !common.IsValidEthHash(msg.ItemID) {
+		return types.ErrInvalidEthItemID(codespace).Result()
+	}
 	if !common.IsValidEthAddress(msg.EthereumSender) {
 		return types.ErrInvalidEthAddress(codespace).Result()
 	}
-	oracleId, validator, claimString := types.CreateOracleClaimFromEthClaim(cdc, msg.EthBridgeClaim)
+	if !types.IsValidRevocationReason(msg.Reason) {
+		return types.ErrInvalidRevocation(codespace).Result()
+	}
+	oracleId, validator, claimString, prophecyID := types.CreateOracleClaimFromEthRevocation(cdc, msg.EthBridgeRevocation)
 	status, err := oracleKeeper.ProcessClaim(ctx, oracleId, validator, claimString)
 	if err != nil {
 		return err.Result()
 	}
+	tags := sdk.EmptyTags()
 	if status.StatusText == oracle.SuccessStatus {
-		err = processSuccessfulClaim(ctx, bankKeeper, status.FinalClaim)
+		prophecyID = lockOracleID(ctx, bridgeKeeper, oracleKeeper, msg.EthBridgeRevocation, prophecyID)
+		clawbackTags, err := processSuccessfulRevocation(ctx, bridgeKeeper, oracleKeeper, bankKeeper, msg.EthBridgeRevocation, prophecyID)
+		if err != nil {
+			return err.Result()
+		}
+		finalizeTags, err := finalizeNonces(ctx, bridgeKeeper, oracleKeeper, bankKeeper, msg.EthereumChainID, msg.BridgeContractAddress)
 		if err != nil {
 			return err.Result()
 		}
+		tags = tags.AppendTags(clawbackTags).AppendTags(finalizeTags)
 	}
-	return sdk.Result{Log: status.StatusText}
+	return sdk.Result{Log: status.StatusText, Tags: tags}
+}
+
+// isNonceMinted returns true if a prophecy other than the one with the given oracle id has already minted the nonce
+func isNonceMinted(ctx sdk.Context, oracleKeeper oracle.Keeper, nonceProphecies []types.NonceProphecy, nonce sdk.Uint, oracleID string) bool {
+	for _, nonceProphecy := range nonceProphecies {
+		if nonceProphecy.OracleID == oracleID {
+			continue
+		}
+		prophecy, err := oracleKeeper.GetProphecy(ctx, nonceProphecy.OracleID)
+		if err != nil || prophecy.Status.StatusText != oracle.SuccessStatus {
+			continue
+		}
+		finalClaim, err := types.CreateOracleClaimFromOracleString(prophecy.Status.FinalClaim)
+		if err == nil && finalClaim.Nonce.Equal(nonce) {
+			return true
+		}
+	}
+	return false
+}
+
+// isMintQueued returns true if the mint of a successful prophecy has to wait for the prophecies on earlier nonces
+// of its bridge contract, which is when minting is sequential and the nonce is not the next to be finalized
+func isMintQueued(ctx sdk.Context, bridgeKeeper keeper.Keeper, nonceProphecy types.NonceProphecy) bool {
+	if !bridgeKeeper.GetParams(ctx).SequentialMinting {
+		return false
+	}
+	id := nonceProphecy.ProphecyID
+	lastFinalized := bridgeKeeper.GetLastFinalizedNonce(ctx, id.EthereumChainID, id.BridgeContractAddress)
+	return nonceProphecy.Nonce.GT(lastFinalized.Add(sdk.NewUint(1)))
+}
+
+// finalizeNonces advances the last finalized nonce of a bridge contract past the nonces which have since been
+// finalized, minting the mints queued on them in order, or holding them if minting is paused
+func finalizeNonces(ctx sdk.Context, bridgeKeeper keeper.Keeper, oracleKeeper oracle.Keeper, bankKeeper bank.Keeper, ethereumChainID int, bridgeContractAddress string) (sdk.Tags, sdk.Error) {
+	tags := sdk.EmptyTags()
+	nonce := bridgeKeeper.GetLastFinalizedNonce(ctx, ethereumChainID, bridgeContractAddress)
+	for {
+		next := nonce.Add(sdk.NewUint(1))
+		if !bridgeKeeper.IsNonceFinalized(ctx, oracleKeeper, ethereumChainID, bridgeContractAddress, next) {
+			break
+		}
+		if queuedMint, ok := bridgeKeeper.GetQueuedMint(ctx, ethereumChainID, bridgeContractAddress, next); ok {
+			mintTags, err := mintOrHold(ctx, bridgeKeeper, oracleKeeper, bankKeeper, queuedMint)
+			if err != nil {
+				return nil, err
+			}
+			tags = tags.AppendTags(mintTags)
+			bridgeKeeper.DeleteQueuedMint(ctx, ethereumChainID, bridgeContractAddress, next)
+		}
+		nonce = next
+	}
+	bridgeKeeper.SetLastFinalizedNonce(ctx, ethereumChainID, bridgeContractAddress, nonce)
+	return tags, nil
 }
 
-func processSuccessfulClaim(ctx sdk.Context, bankKeeper bank.Keeper, claim string) sdk.Error {
-	oracleClaim, err := types.CreateOracleClaimFromOracleString(claim)
+// lockOracleID returns the oracle id of the lock prophecy a revocation revokes. Locks migrated from version 0
+// prophecy ids whose item id was not known are still stored under their old id, which is found through the nonce.
+func lockOracleID(ctx sdk.Context, bridgeKeeper keeper.Keeper, oracleKeeper oracle.Keeper, revocation types.EthBridgeRevocation, oracleID string) string {
+	if _, err := oracleKeeper.GetProphecy(ctx, oracleID); err == nil {
+		return oracleID
+	}
+	nonceProphecies := bridgeKeeper.GetNonceProphecies(ctx, revocation.EthereumChainID, revocation.BridgeContractAddress, revocation.Nonce)
+	for _, nonceProphecy := range nonceProphecies {
+		if nonceProphecy.ProphecyID.ItemID == "" {
+			return nonceProphecy.OracleID
+		}
+	}
+	return oracleID
+}
+
+// processSuccessfulRevocation revokes the lock's prophecy so it can no longer mint, and claws back whatever
+// part of the coins sent to the receiver it still holds if it already had, by sending them back to the ethbridge
+// module account and burning them there. The bridge fee stays with the validators who relayed the lock. A mint
+// still queued, held while minting is paused, held in escrow or delayed by the mint limits, is dropped instead, and
+// the refund of a lock made to an invalid receiver is marked as refunded. A lock which was already revoked, such as
+// by the cancellation of its escrowed mint, has nothing left to revoke or claw back.
+func processSuccessfulRevocation(ctx sdk.Context, bridgeKeeper keeper.Keeper, oracleKeeper oracle.Keeper, bankKeeper bank.Keeper, revocation types.EthBridgeRevocation, prophecyID string) (sdk.Tags, sdk.Error) {
+	if lock, err := oracleKeeper.GetProphecy(ctx, prophecyID); err == nil && lock.Status.StatusText == oracle.RevokedStatus {
+		return nil, nil
+	}
+	prophecy, err := oracleKeeper.RevokeProphecy(ctx, prophecyID)
+	if err != nil {
+		return nil, err
+	}
+	if prophecy.Status.StatusText != oracle.SuccessStatus {
+		return nil, nil
+	}
+	oracleClaim, err := types.CreateOracleClaimFromOracleString(prophecy.Status.FinalClaim)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	if oracleClaim.IsInvalidReceiver() {
+		lockProphecyID := types.NewProphecyID(revocation.EthereumChainID, revocation.BridgeContractAddress, revocation.ItemID)
+		if refund, ok := bridgeKeeper.GetRefund(ctx, oracleClaim.EthereumSender, lockProphecyID); ok {
+			refund.Status = types.RefundStatusRefunded
+			bridgeKeeper.SetRefund(ctx, refund)
+		}
+		return nil, nil
+	}
+	queuedMint, ok := bridgeKeeper.GetQueuedMint(ctx, revocation.EthereumChainID, revocation.BridgeContractAddress, oracleClaim.Nonce)
+	if ok && queuedMint.OracleID == prophecyID {
+		bridgeKeeper.DeleteQueuedMint(ctx, revocation.EthereumChainID, revocation.BridgeContractAddress, oracleClaim.Nonce)
+		return nil, nil
+	}
+	pausedMint, ok := bridgeKeeper.GetPausedMint(ctx, revocation.EthereumChainID, revocation.BridgeContractAddress, oracleClaim.Nonce)
+	if ok && pausedMint.OracleID == prophecyID {
+		bridgeKeeper.DeletePausedMint(ctx, revocation.EthereumChainID, revocation.BridgeContractAddress, oracleClaim.Nonce)
+		return nil, nil
+	}
+	escrowedMint, ok := bridgeKeeper.GetEscrowedMint(ctx, revocation.EthereumChainID, revocation.BridgeContractAddress, oracleClaim.Nonce)
+	if ok && escrowedMint.NonceProphecy.OracleID == prophecyID {
+		bridgeKeeper.DeleteEscrowedMint(ctx, revocation.EthereumChainID, revocation.BridgeContractAddress, oracleClaim.Nonce)
+		return nil, nil
+	}
+	delayedMint, ok := bridgeKeeper.GetDelayedMint(ctx, revocation.EthereumChainID, revocation.BridgeContractAddress, oracleClaim.Nonce)
+	if ok && delayedMint.NonceProphecy.OracleID == prophecyID {
+		bridgeKeeper.DeleteDelayedMint(ctx, revocation.EthereumChainID, revocation.BridgeContractAddress, oracleClaim.Nonce)
+		return nil, nil
 	}
 	receiverAddress := oracleClaim.CosmosReceiver
-	_, _, err = bankKeeper.AddCoins(ctx, receiverAddress, oracleClaim.Amount)
+	received := oracleClaim.Amount.Sub(bridgeKeeper.GetMintFee(ctx, prophecyID))
+	clawback := types.ClawbackAmount(received, bankKeeper.GetCoins(ctx, receiverAddress))
+	if clawback.IsZero() {
+		return nil, nil
+	}
+	tags, err := bridgeKeeper.SendCoinsFromAccountToModule(ctx, bankKeeper, receiverAddress, clawback)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	err = bridgeKeeper.BurnCoins(ctx, bankKeeper, clawback)
+	if err != nil {
+		return nil, err
+	}
+	return tags, nil
+}
+
+// Handle a message attesting that locking on the bridge contract was paused or activated
+func handleMsgMakeBridgeStatusClaim(ctx sdk.Context, cdc *codec.Codec, bridgeKeeper keeper.Keeper, oracleKeeper oracle.Keeper, msg MsgMakeBridgeStatusClaim, codespace sdk.CodespaceType) sdk.Result {
+	if msg.EthereumChainID <= 0 {
+		return types.ErrInvalidChainID(codespace).Result()
+	}
+	if msg.EthereumBlock == 0 {
+		return types.ErrInvalidEthBlock(codespace).Result()
+	}
+	oracleId, validator, claimString := types.CreateOracleClaimFromBridgeStatusClaim(cdc, msg.BridgeStatusClaim)
+	status, err := oracleKeeper.ProcessClaim(ctx, oracleId, validator, claimString)
+	if err != nil {
+		return err.Result()
+	}
+	if status.StatusText == oracle.SuccessStatus {
+		bridgeStatus, err := types.CreateBridgeStatusFromOracleString(status.FinalClaim)
+		if err != nil {
+			return err.Result()
+		}
+		bridgeKeeper.SetBridgeStatus(ctx, bridgeStatus)
+	}
+	return sdk.Result{Log: status.StatusText}
+}
+
+// Handle a message from the bridge admin pausing or resuming minting. Resuming mints the coins held while minting
+// was paused.
+func handleMsgSetBridgeStatus(ctx sdk.Context, bridgeKeeper keeper.Keeper, oracleKeeper oracle.Keeper, bankKeeper bank.Keeper, msg MsgSetBridgeStatus, codespace sdk.CodespaceType) sdk.Result {
+	admin := bridgeKeeper.GetParams(ctx).Admin
+	if admin.Empty() || !admin.Equals(msg.Admin) {
+		return types.ErrUnauthorizedAdmin(codespace).Result()
+	}
+	bridgeKeeper.SetMintingPaused(ctx, msg.Paused)
+	if msg.Paused {
+		return sdk.Result{Log: types.MintingStatusPaused}
+	}
+	tags, err := releasePausedMints(ctx, bridgeKeeper, oracleKeeper, bankKeeper)
+	if err != nil {
+		return err.Result()
+	}
+	return sdk.Result{Log: types.MintingStatusActive, Tags: tags}
+}
+
+// Handle a message from a validator withdrawing the bridge fees it has earned by relaying claims
+func handleMsgWithdrawRelayerRewards(ctx sdk.Context, bridgeKeeper keeper.Keeper, bankKeeper bank.Keeper, msg MsgWithdrawRelayerRewards) sdk.Result {
+	rewards, tags, err := bridgeKeeper.WithdrawRelayerRewards(ctx, bankKeeper, msg.Validator)
+	if err != nil {
+		return err.Result()
+	}
+	return sdk.Result{Log: rewards.String(), Tags: tags}
+}
+
+// Handle a message from the bridge admin setting the mint limits
+func handleMsgSetMintLimits(ctx sdk.Context, bridgeKeeper keeper.Keeper, msg MsgSetMintLimits, codespace sdk.CodespaceType) sdk.Result {
+	params := bridgeKeeper.GetParams(ctx)
+	if params.Admin.Empty() || !params.Admin.Equals(msg.Admin) {
+		return types.ErrUnauthorizedAdmin(codespace).Result()
+	}
+	if err := msg.MintLimits.ValidateBasic(codespace); err != nil {
+		return err.Result()
+	}
+	params.MintLimitWindow = msg.MintLimits.Window
+	params.GlobalMintLimit = msg.MintLimits.GlobalLimit
+	params.ReceiverMintLimit = msg.MintLimits.ReceiverLimit
+	bridgeKeeper.SetParams(ctx, params)
+	return sdk.Result{Log: msg.MintLimits.String()}
+}
+
+// Handle a message from a validator disputing a mint held in escrow. Once the validators disputing it hold enough
+// power for consensus, the mint is cancelled and its prophecy revoked, so that it can never mint.
+func handleMsgDisputeEscrowedMint(ctx sdk.Context, bridgeKeeper keeper.Keeper, oracleKeeper oracle.Keeper, msg MsgDisputeEscrowedMint, codespace sdk.CodespaceType) sdk.Result {
+	if !types.IsValidNonce(msg.Nonce) {
+		return types.ErrInvalidEthNonce(codespace).Result()
+	}
+	escrowedMint, ok := bridgeKeeper.GetEscrowedMint(ctx, msg.EthereumChainID, msg.BridgeContractAddress, msg.Nonce)
+	if !ok {
+		return types.ErrEscrowNotFound(codespace).Result()
+	}
+	validator := sdk.ValAddress(msg.Validator)
+	if oracleKeeper.GetValidatorPower(ctx, validator) == 0 || escrowedMint.HasDisputed(validator) {
+		return types.ErrInvalidDispute(codespace).Result()
+	}
+	escrowedMint.Disputes = append(escrowedMint.Disputes, validator)
+	if !oracleKeeper.HasConsensus(ctx, escrowedMint.Disputes) {
+		bridgeKeeper.SetEscrowedMint(ctx, escrowedMint)
+		return sdk.Result{Log: types.EscrowStatusDisputed}
+	}
+	bridgeKeeper.DeleteEscrowedMint(ctx, msg.EthereumChainID, msg.BridgeContractAddress, msg.Nonce)
+	if _, err := oracleKeeper.RevokeProphecy(ctx, escrowedMint.NonceProphecy.OracleID); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{Log: types.EscrowStatusCancelled}
+}
+
+// mintOrHold mints the coins of a successful prophecy, or holds them until minting is resumed if it is paused
+func mintOrHold(ctx sdk.Context, bridgeKeeper keeper.Keeper, oracleKeeper oracle.Keeper, bankKeeper bank.Keeper, nonceProphecy types.NonceProphecy) (sdk.Tags, sdk.Error) {
+	if bridgeKeeper.IsMintingPaused(ctx) {
+		bridgeKeeper.SetPausedMint(ctx, nonceProphecy)
+		return nil, nil
+	}
+	return escrowOrMint(ctx, bridgeKeeper, oracleKeeper, bankKeeper, nonceProphecy)
+}
+
+// escrowOrMint mints the coins of a successful prophecy, or holds them in escrow for validators to dispute if they
+// are above the escrow threshold
+func escrowOrMint(ctx sdk.Context, bridgeKeeper keeper.Keeper, oracleKeeper oracle.Keeper, bankKeeper bank.Keeper, nonceProphecy types.NonceProphecy) (sdk.Tags, sdk.Error) {
+	prophecy, oracleClaim, err := getFinalClaim(ctx, oracleKeeper, nonceProphecy)
+	if err != nil {
+		return nil, err
+	}
+	params := bridgeKeeper.GetParams(ctx)
+	if params.IsEscrowed(oracleClaim.Amount) {
+		bridgeKeeper.SetEscrowedMint(ctx, types.NewEscrowedMint(nonceProphecy, ctx.BlockHeight()+params.EscrowBlocks))
+		return nil, nil
+	}
+	return mintOrDelay(ctx, bridgeKeeper, oracleKeeper, bankKeeper, nonceProphecy, prophecy, oracleClaim)
+}
+
+// getFinalClaim returns the prophecy of a nonce and its final claim
+func getFinalClaim(ctx sdk.Context, oracleKeeper oracle.Keeper, nonceProphecy types.NonceProphecy) (oracle.Prophecy, types.OracleClaim, sdk.Error) {
+	prophecy, err := oracleKeeper.GetProphecy(ctx, nonceProphecy.OracleID)
+	if err != nil {
+		return oracle.Prophecy{}, types.OracleClaim{}, err
+	}
+	oracleClaim, err := types.CreateOracleClaimFromOracleString(prophecy.Status.FinalClaim)
+	if err != nil {
+		return oracle.Prophecy{}, types.OracleClaim{}, err
+	}
+	return prophecy, oracleClaim, nil
+}
+
+// mintOrDelay mints the coins of a successful prophecy, or delays them until they fit if minting them would go over
+// the mint limits
+func mintOrDelay(ctx sdk.Context, bridgeKeeper keeper.Keeper, oracleKeeper oracle.Keeper, bankKeeper bank.Keeper, nonceProphecy types.NonceProphecy,
+	prophecy oracle.Prophecy, oracleClaim types.OracleClaim) (sdk.Tags, sdk.Error) {
+	if !bridgeKeeper.IsWithinMintLimits(ctx, oracleClaim.CosmosReceiver, oracleClaim.Amount) {
+		bridgeKeeper.SetDelayedMint(ctx, types.NewDelayedMint(nonceProphecy, ctx.BlockHeight()))
+		return nil, nil
+	}
+	return processSuccessfulClaim(ctx, bridgeKeeper, oracleKeeper, bankKeeper, prophecy)
+}
+
+// releasePausedMints mints the coins held while minting was paused, in order of their nonces, escrowing or delaying
+// them as they would have been when their prophecies succeeded
+func releasePausedMints(ctx sdk.Context, bridgeKeeper keeper.Keeper, oracleKeeper oracle.Keeper, bankKeeper bank.Keeper) (sdk.Tags, sdk.Error) {
+	tags := sdk.EmptyTags()
+	var pausedMints []types.NonceProphecy
+	bridgeKeeper.IteratePausedMints(ctx, func(pausedMint types.NonceProphecy) bool {
+		pausedMints = append(pausedMints, pausedMint)
+		return false
+	})
+	for _, pausedMint := range pausedMints {
+		mintTags, err := escrowOrMint(ctx, bridgeKeeper, oracleKeeper, bankKeeper, pausedMint)
+		if err != nil {
+			return nil, err
+		}
+		tags = tags.AppendTags(mintTags)
+		id := pausedMint.ProphecyID
+		bridgeKeeper.DeletePausedMint(ctx, id.EthereumChainID, id.BridgeContractAddress, pausedMint.Nonce)
+	}
+	return tags, nil
+}
+
+// processSuccessfulClaim mints the claimed coins of a successful prophecy into the ethbridge module account and
+// sends them to the receiver, so that each mint shows up as a transfer from the module account. The bridge fee is
+// kept in the module account and split between the validators whose claims matched the final claim.
+func processSuccessfulClaim(ctx sdk.Context, bridgeKeeper keeper.Keeper, oracleKeeper oracle.Keeper, bankKeeper bank.Keeper, prophecy oracle.Prophecy) (sdk.Tags, sdk.Error) {
+	oracleClaim, err := types.CreateOracleClaimFromOracleString(prophecy.Status.FinalClaim)
+	if err != nil {
+		return nil, err
+	}
+	err = bridgeKeeper.MintCoins(ctx, bankKeeper, oracleClaim.Amount)
+	if err != nil {
+		return nil, err
+	}
+	bridgeKeeper.RecordWindowMint(ctx, oracleClaim.CosmosReceiver, oracleClaim.Amount)
+	params := bridgeKeeper.GetParams(ctx)
+	fee := types.BridgeFee(oracleClaim.Amount, params.BridgeFeeFlat, params.BridgeFeeBasisPoints)
+	if !fee.IsZero() {
+		bridgeKeeper.SetMintFee(ctx, prophecy.ID, fee)
+		bridgeKeeper.AllocateRelayerRewards(ctx, oracleKeeper, fee, prophecy.ClaimValidators[prophecy.Status.FinalClaim])
+	}
+	received := oracleClaim.Amount.Sub(fee)
+	if received.IsZero() {
+		return nil, nil
 	}
-	return nil
+	return bridgeKeeper.SendCoinsFromModuleToAccount(ctx, bankKeeper, oracleClaim.CosmosReceiver, received)
 }
@@ -1,26 +1,29 @@
 package ethbridge
 
 import (
+	"math/big"
 	"strings"
 	"testing"
 
+	gethCommon "github.com/ethereum/go-ethereum/common"
+
 	"github.com/swishlabsco/cosmos-ethereum-bridge/x/oracle"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/stretchr/testify/require"
+	bridgeKeeperLib "github.com/swishlabsco/cosmos-ethereum-bridge/x/ethbridge/keeper"
 	"github.com/swishlabsco/cosmos-ethereum-bridge/x/ethbridge/types"
-	keeperLib "github.com/swishlabsco/cosmos-ethereum-bridge/x/oracle/keeper"
 )
 
 func TestBasicMsgs(t *testing.T) {
 	//Setup
 	cdc := codec.New()
-	ctx, _, keeper, bankKeeper, validatorAddresses, _ := keeperLib.CreateTestKeepers(t, 0.7, []int64{3, 7})
+	ctx, bridgeKeeper, keeper, bankKeeper, validatorAddresses := bridgeKeeperLib.CreateTestKeepers(t, 0.7, []int64{3, 7})
 	accAddress := sdk.AccAddress(validatorAddresses[0])
 
-	handler := NewHandler(keeper, bankKeeper, cdc, types.DefaultCodespace)
+	handler := NewHandler(bridgeKeeper, keeper, bankKeeper, cdc, types.DefaultCodespace)
 
 	//Unrecognized type
 	res := handler(ctx, sdk.NewTestMsg())
@@ -34,24 +37,71 @@ func TestBasicMsgs(t *testing.T) {
 
 	//Bad Creation
 	badCreateMsg := types.CreateTestEthMsg(t, accAddress)
-	badCreateMsg.Nonce = -1
+	badCreateMsg.EthereumSender = "badAddress"
 	res = handler(ctx, badCreateMsg)
 	require.False(t, res.IsOK())
-	require.True(t, strings.Contains(res.Log, "invalid ethereum nonce provided"))
+	require.True(t, strings.Contains(res.Log, "invalid ethereum address provided"))
 
 	badCreateMsg = types.CreateTestEthMsg(t, accAddress)
-	badCreateMsg.EthereumSender = "badAddress"
+	badCreateMsg.BridgeContractAddress = "badAddress"
 	res = handler(ctx, badCreateMsg)
 	require.False(t, res.IsOK())
 	require.True(t, strings.Contains(res.Log, "invalid ethereum address provided"))
+
+	badCreateMsg = types.CreateTestEthMsg(t, accAddress)
+	badCreateMsg.EthereumChainID = 0
+	res = handler(ctx, badCreateMsg)
+	require.False(t, res.IsOK())
+	require.True(t, strings.Contains(res.Log, "invalid ethereum chain id provided"))
+
+	//Claims and revocations without a nonce, or with a nonce the bridge contract never gives, are rejected
+	for _, nonce := range []sdk.Uint{{}, sdk.ZeroUint()} {
+		badCreateMsg = types.CreateTestEthMsg(t, accAddress)
+		badCreateMsg.Nonce = nonce
+		require.Equal(t, types.CodeInvalidEthNonce, badCreateMsg.ValidateBasic().Code())
+		res = handler(ctx, badCreateMsg)
+		require.False(t, res.IsOK())
+		require.Equal(t, types.CodeInvalidEthNonce, res.Code)
+
+		badRevokeMsg := types.CreateTestRevocationMsg(t, accAddress, types.RevocationReasonWithdraw)
+		badRevokeMsg.Nonce = nonce
+		require.Equal(t, types.CodeInvalidEthNonce, badRevokeMsg.ValidateBasic().Code())
+		res = handler(ctx, badRevokeMsg)
+		require.False(t, res.IsOK())
+		require.Equal(t, types.CodeInvalidEthNonce, res.Code)
+	}
+
+	badCreateMsg = types.CreateTestEthMsg(t, accAddress)
+	badCreateMsg.EthereumProvenance.TxHash = "0x1234"
+	res = handler(ctx, badCreateMsg)
+	require.False(t, res.IsOK())
+	require.True(t, strings.Contains(res.Log, "invalid ethereum transaction hash provided"))
+
+	badCreateMsg = types.CreateTestEthMsg(t, accAddress)
+	badCreateMsg.ItemID = "item"
+	res = handler(ctx, badCreateMsg)
+	require.False(t, res.IsOK())
+	require.True(t, strings.Contains(res.Log, "invalid ethereum item id provided"))
+
+	badCreateMsg = types.CreateTestEthMsg(t, accAddress)
+	badCreateMsg.ItemID = types.TestEthereumItemID[:len(types.TestEthereumItemID)-2]
+	res = handler(ctx, badCreateMsg)
+	require.False(t, res.IsOK())
+	require.True(t, strings.Contains(res.Log, "invalid ethereum item id provided"))
+
+	badRevokeMsg := types.CreateTestRevocationMsg(t, accAddress, types.RevocationReasonWithdraw)
+	badRevokeMsg.ItemID = ""
+	res = handler(ctx, badRevokeMsg)
+	require.False(t, res.IsOK())
+	require.True(t, strings.Contains(res.Log, "invalid ethereum item id provided"))
 }
 
 func TestDuplicateMsgs(t *testing.T) {
 	cdc := codec.New()
-	ctx, _, keeper, bankKeeper, validatorAddresses, _ := keeperLib.CreateTestKeepers(t, 0.7, []int64{3, 7})
+	ctx, bridgeKeeper, keeper, bankKeeper, validatorAddresses := bridgeKeeperLib.CreateTestKeepers(t, 0.7, []int64{3, 7})
 	accAddress := sdk.AccAddress(validatorAddresses[0])
 
-	handler := NewHandler(keeper, bankKeeper, cdc, types.DefaultCodespace)
+	handler := NewHandler(bridgeKeeper, keeper, bankKeeper, cdc, types.DefaultCodespace)
 	normalCreateMsg := types.CreateTestEthMsg(t, accAddress)
 	res := handler(ctx, normalCreateMsg)
 	require.True(t, res.IsOK())
@@ -62,17 +112,44 @@ func TestDuplicateMsgs(t *testing.T) {
 	require.False(t, res.IsOK())
 	require.True(t, strings.Contains(res.Log, "Already processed message from validator for this id"))
 
+	//The same lock on another ethereum chain is a different prophecy
+	otherChainMsg := types.CreateTestEthMsg(t, accAddress)
+	otherChainMsg.EthereumChainID = types.TestEthereumChainID + 1
+	res = handler(ctx, otherChainMsg)
+	require.True(t, res.IsOK())
+	require.Equal(t, res.Log, oracle.PendingStatus)
+
+	//As is the same item on another bridge contract
+	otherContractMsg := types.CreateTestEthMsg(t, accAddress)
+	otherContractMsg.BridgeContractAddress = types.TestEthereumAddress
+	res = handler(ctx, otherContractMsg)
+	require.True(t, res.IsOK())
+	require.Equal(t, res.Log, oracle.PendingStatus)
+
+	//The sender and nonce are not part of the prophecy id, so claims naming another sender or nonce are on the
+	//same prophecy
+	otherSenderMsg := types.CreateTestEthMsg(t, accAddress)
+	otherSenderMsg.EthereumSender = types.AltTestEthereumAddress
+	res = handler(ctx, otherSenderMsg)
+	require.False(t, res.IsOK())
+	require.True(t, strings.Contains(res.Log, "Already processed message from validator for this id"))
+
+	otherNonceMsg := types.CreateTestEthMsg(t, accAddress)
+	otherNonceMsg.Nonce = sdk.NewUint(types.TestNonce + 1)
+	res = handler(ctx, otherNonceMsg)
+	require.False(t, res.IsOK())
+	require.True(t, strings.Contains(res.Log, "Already processed message from validator for this id"))
 }
 
 func TestMintSuccess(t *testing.T) {
 	//Setup
 	cdc := codec.New()
-	ctx, _, keeper, bankKeeper, validatorAddresses, _ := keeperLib.CreateTestKeepers(t, 0.7, []int64{2, 7, 1})
+	ctx, bridgeKeeper, keeper, bankKeeper, validatorAddresses := bridgeKeeperLib.CreateTestKeepers(t, 0.7, []int64{2, 7, 1})
 	accAddressVal1Pow2 := sdk.AccAddress(validatorAddresses[0])
 	accAddressVal2Pow7 := sdk.AccAddress(validatorAddresses[1])
 	accAddressVal3Pow1 := sdk.AccAddress(validatorAddresses[2])
 
-	handler := NewHandler(keeper, bankKeeper, cdc, types.DefaultCodespace)
+	handler := NewHandler(bridgeKeeper, keeper, bankKeeper, cdc, types.DefaultCodespace)
 
 	//Initial message
 	normalCreateMsg := types.CreateTestEthMsg(t, accAddressVal1Pow2)
@@ -90,6 +167,12 @@ func TestMintSuccess(t *testing.T) {
 	require.NoError(t, err)
 	require.True(t, receiverCoins.IsEqual(expectedCoins))
 	require.Equal(t, res.Log, oracle.SuccessStatus)
+	require.True(t, bridgeKeeper.GetBridgedSupply(ctx).IsEqual(expectedCoins))
+
+	//The coins were minted into the module account and sent on to the receiver
+	require.True(t, bridgeKeeper.GetMinted(ctx).IsEqual(expectedCoins))
+	require.True(t, bankKeeper.GetCoins(ctx, types.ModuleAddress).IsZero())
+	require.Contains(t, res.Tags, sdk.MakeTag("sender", types.ModuleAddress.String()))
 
 	//Additional message from third validator fails and does not mint
 	normalCreateMsg = types.CreateTestEthMsg(t, accAddressVal3Pow1)
@@ -106,7 +189,7 @@ func TestMintSuccess(t *testing.T) {
 func TestNoMintFail(t *testing.T) {
 	//Setup
 	cdc := codec.New()
-	ctx, _, keeper, bankKeeper, validatorAddresses, _ := keeperLib.CreateTestKeepers(t, 0.7, []int64{3, 4, 3})
+	ctx, bridgeKeeper, keeper, bankKeeper, validatorAddresses := bridgeKeeperLib.CreateTestKeepers(t, 0.7, []int64{3, 4, 3})
 	accAddressVal1Pow3 := sdk.AccAddress(validatorAddresses[0])
 	accAddressVal2Pow4 := sdk.AccAddress(validatorAddresses[1])
 	accAddressVal3Pow3 := sdk.AccAddress(validatorAddresses[2])
@@ -118,7 +201,7 @@ func TestNoMintFail(t *testing.T) {
 	ethClaim3 := types.CreateTestEthClaim(t, accAddressVal3Pow3, types.TestEthereumAddress, types.AltTestCoins)
 	ethMsg3 := NewMsgMakeEthBridgeClaim(ethClaim3)
 
-	handler := NewHandler(keeper, bankKeeper, cdc, types.DefaultCodespace)
+	handler := NewHandler(bridgeKeeper, keeper, bankKeeper, cdc, types.DefaultCodespace)
 
 	//Initial message
 	res := handler(ctx, ethMsg1)
@@ -126,18 +209,775 @@ func TestNoMintFail(t *testing.T) {
 	require.True(t, strings.Contains(res.Log, oracle.PendingStatus))
 	require.Equal(t, res.Log, oracle.PendingStatus)
 
-	//Different message from second validator succeeds
+	//Different message from second validator succeeds but results in failed prophecy, as the remaining power
+	//can no longer bring either claim above the threshold
 	res = handler(ctx, ethMsg2)
 	require.True(t, res.IsOK())
-	require.True(t, strings.Contains(res.Log, oracle.PendingStatus))
-	require.Equal(t, res.Log, oracle.PendingStatus)
+	require.Equal(t, res.Log, oracle.FailedStatus)
 
-	//Different message from third validator succeeds but results in failed prophecy with no minting
+	//Different message from third validator is rejected as the prophecy is finalized, and nothing is minted
 	res = handler(ctx, ethMsg3)
-	require.True(t, res.IsOK())
-	require.True(t, strings.Contains(res.Log, oracle.FailedStatus))
+	require.False(t, res.IsOK())
+	require.True(t, strings.Contains(res.Log, "Prophecy already finalized"))
 	receiverAddress, err := sdk.AccAddressFromBech32(types.TestAddress)
 	require.NoError(t, err)
 	receiver1Coins := bankKeeper.GetCoins(ctx, receiverAddress)
 	require.True(t, receiver1Coins.IsZero())
 }
+
+func TestCompetingProvenance(t *testing.T) {
+	//Setup
+	cdc := codec.New()
+	ctx, bridgeKeeper, keeper, bankKeeper, validatorAddresses := bridgeKeeperLib.CreateTestKeepers(t, 0.7, []int64{2, 3, 5})
+	accAddressVal1Pow2 := sdk.AccAddress(validatorAddresses[0])
+	accAddressVal2Pow3 := sdk.AccAddress(validatorAddresses[1])
+
+	handler := NewHandler(bridgeKeeper, keeper, bankKeeper, cdc, types.DefaultCodespace)
+
+	//Claims on the same lock made from different ethereum logs are different claims, which leave the prophecy
+	//pending while the third validator could still carry either of them
+	res := handler(ctx, types.CreateTestEthMsg(t, accAddressVal1Pow2))
+	require.True(t, res.IsOK())
+	otherLogMsg := types.CreateTestEthMsg(t, accAddressVal2Pow3)
+	otherLogMsg.EthereumProvenance.LogIndex++
+	res = handler(ctx, otherLogMsg)
+	require.True(t, res.IsOK())
+	require.Equal(t, res.Log, oracle.PendingStatus)
+
+	prophecyID := types.NewProphecyID(types.TestEthereumChainID, types.TestBridgeContract, types.TestEthereumItemID)
+	prophecy, err := keeper.GetProphecy(ctx, string(prophecyID.Key()))
+	require.NoError(t, err)
+	require.Len(t, prophecy.ClaimValidators, 2)
+
+	//The provenance of each claim can be read back from the prophecy
+	for _, claimString := range prophecy.ValidatorClaims {
+		oracleClaim, err := types.CreateOracleClaimFromOracleString(claimString)
+		require.NoError(t, err)
+		require.Equal(t, types.TestEthereumTxHash, oracleClaim.EthereumProvenance.TxHash)
+		require.Equal(t, sdk.NewUint(types.TestNonce), oracleClaim.Nonce)
+	}
+}
+
+func TestLargeNonceAndAmount(t *testing.T) {
+	//Setup
+	cdc := codec.New()
+	ctx, bridgeKeeper, keeper, bankKeeper, validatorAddresses := bridgeKeeperLib.CreateTestKeepers(t, 0.7, []int64{3, 7})
+	accAddressVal2Pow7 := sdk.AccAddress(validatorAddresses[1])
+
+	handler := NewHandler(bridgeKeeper, keeper, bankKeeper, cdc, types.DefaultCodespace)
+
+	//Nonces and amounts beyond 2^63 are processed without losing precision
+	largeCoins, err := sdk.ParseCoins("36893488147419103232ethereum")
+	require.NoError(t, err)
+	largeNonce, nonceErr := types.ParseNonce(types.TestLargeNonce)
+	require.NoError(t, nonceErr)
+	ethClaim := types.CreateTestEthClaim(t, accAddressVal2Pow7, types.TestEthereumAddress, types.TestCoins)
+	ethClaim.Nonce = largeNonce
+	ethClaim.Amount = largeCoins
+	res := handler(ctx, types.NewMsgMakeEthBridgeClaim(ethClaim))
+	require.True(t, res.IsOK())
+	require.Equal(t, res.Log, oracle.SuccessStatus)
+
+	receiverAddress, err := sdk.AccAddressFromBech32(types.TestAddress)
+	require.NoError(t, err)
+	require.True(t, bankKeeper.GetCoins(ctx, receiverAddress).IsEqual(largeCoins))
+
+	prophecy, err := keeper.GetProphecy(ctx, string(ethClaim.ProphecyID().Key()))
+	require.NoError(t, err)
+	oracleClaim, err := types.CreateOracleClaimFromOracleString(prophecy.Status.FinalClaim)
+	require.NoError(t, err)
+	require.Equal(t, types.TestLargeNonce, oracleClaim.Nonce.String())
+
+	//The nonce the large nonce would overflow to is a different nonce, which another item can mint
+	overflowedClaim := types.CreateTestEthClaim(t, accAddressVal2Pow7, types.TestEthereumAddress, types.TestCoins)
+	overflowedClaim.ItemID = types.AltTestEthereumItemID
+	overflowedClaim.Nonce = sdk.NewUint(types.NonceBigInt(largeNonce).Uint64())
+	res = handler(ctx, types.NewMsgMakeEthBridgeClaim(overflowedClaim))
+	require.True(t, res.IsOK())
+	require.Equal(t, res.Log, oracle.SuccessStatus)
+
+	//Nonces which are not unsigned 256 bit integers are rejected
+	_, err = types.ParseNonce("-1")
+	require.Error(t, err)
+	_, err = types.ParseNonce("115792089237316195423570985008687907853269984665640564039457584007913129639936")
+	require.Error(t, err)
+}
+
+func TestNonceAlreadyMinted(t *testing.T) {
+	//Setup
+	cdc := codec.New()
+	ctx, bridgeKeeper, keeper, bankKeeper, validatorAddresses := bridgeKeeperLib.CreateTestKeepers(t, 0.7, []int64{3, 7})
+	accAddressVal1Pow3 := sdk.AccAddress(validatorAddresses[0])
+	accAddressVal2Pow7 := sdk.AccAddress(validatorAddresses[1])
+
+	handler := NewHandler(bridgeKeeper, keeper, bankKeeper, cdc, types.DefaultCodespace)
+
+	//A claim on another item with the same nonce is left pending
+	otherItemMsg := types.CreateTestEthMsg(t, accAddressVal1Pow3)
+	otherItemMsg.ItemID = types.AltTestEthereumItemID
+	res := handler(ctx, otherItemMsg)
+	require.True(t, res.IsOK())
+	require.Equal(t, res.Log, oracle.PendingStatus)
+
+	//The nonce is minted by the first prophecy to succeed
+	res = handler(ctx, types.CreateTestEthMsg(t, accAddressVal2Pow7))
+	require.True(t, res.IsOK())
+	require.Equal(t, res.Log, oracle.SuccessStatus)
+
+	//Both prophecies are indexed by the nonce, so the conflict can be found
+	nonceProphecies := bridgeKeeper.GetNonceProphecies(ctx, types.TestEthereumChainID, types.TestBridgeContract, sdk.NewUint(types.TestNonce))
+	require.Len(t, nonceProphecies, 2)
+	require.Equal(t, types.AltTestEthereumItemID, nonceProphecies[0].ProphecyID.ItemID)
+	require.Equal(t, types.TestEthereumItemID, nonceProphecies[1].ProphecyID.ItemID)
+
+	//The other prophecy can no longer mint the nonce
+	otherItemMsg = types.CreateTestEthMsg(t, accAddressVal2Pow7)
+	otherItemMsg.ItemID = types.AltTestEthereumItemID
+	res = handler(ctx, otherItemMsg)
+	require.False(t, res.IsOK())
+	require.True(t, strings.Contains(res.Log, "already minted by another prophecy"))
+	receiverAddress, err := sdk.AccAddressFromBech32(types.TestAddress)
+	require.NoError(t, err)
+	expectedCoins, err := sdk.ParseCoins(types.TestCoins)
+	require.NoError(t, err)
+	require.True(t, bankKeeper.GetCoins(ctx, receiverAddress).IsEqual(expectedCoins))
+
+	//But it can mint another nonce
+	otherItemMsg.Nonce = sdk.NewUint(types.TestNonce + 1)
+	res = handler(ctx, otherItemMsg)
+	require.True(t, res.IsOK())
+	require.Equal(t, res.Log, oracle.SuccessStatus)
+}
+
+func TestRevokeBeforeMint(t *testing.T) {
+	//Setup
+	cdc := codec.New()
+	ctx, bridgeKeeper, keeper, bankKeeper, validatorAddresses := bridgeKeeperLib.CreateTestKeepers(t, 0.7, []int64{2, 7, 1})
+	accAddressVal1Pow2 := sdk.AccAddress(validatorAddresses[0])
+	accAddressVal2Pow7 := sdk.AccAddress(validatorAddresses[1])
+
+	handler := NewHandler(bridgeKeeper, keeper, bankKeeper, cdc, types.DefaultCodespace)
+
+	//Initial claim leaves the prophecy pending
+	res := handler(ctx, types.CreateTestEthMsg(t, accAddressVal1Pow2))
+	require.True(t, res.IsOK())
+	require.Equal(t, res.Log, oracle.PendingStatus)
+
+	//Revocation by a validator with enough power succeeds
+	res = handler(ctx, types.CreateTestRevocationMsg(t, accAddressVal2Pow7, types.RevocationReasonWithdraw))
+	require.True(t, res.IsOK())
+	require.Equal(t, res.Log, oracle.SuccessStatus)
+
+	id, _, _ := types.CreateOracleClaimFromEthClaim(cdc, types.CreateTestEthClaim(t, accAddressVal2Pow7, types.TestEthereumAddress, types.TestCoins))
+	prophecy, err := keeper.GetProphecy(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, prophecy.Status.StatusText, oracle.RevokedStatus)
+
+	//Later claims on the revoked lock can no longer mint
+	res = handler(ctx, types.CreateTestEthMsg(t, accAddressVal2Pow7))
+	require.False(t, res.IsOK())
+	require.True(t, strings.Contains(res.Log, "Prophecy already finalized"))
+	receiverAddress, addrErr := sdk.AccAddressFromBech32(types.TestAddress)
+	require.NoError(t, addrErr)
+	require.True(t, bankKeeper.GetCoins(ctx, receiverAddress).IsZero())
+}
+
+func TestRevokeAfterMint(t *testing.T) {
+	//Setup
+	cdc := codec.New()
+	ctx, bridgeKeeper, keeper, bankKeeper, validatorAddresses := bridgeKeeperLib.CreateTestKeepers(t, 0.7, []int64{2, 7, 1})
+	accAddressVal1Pow2 := sdk.AccAddress(validatorAddresses[0])
+	accAddressVal2Pow7 := sdk.AccAddress(validatorAddresses[1])
+
+	handler := NewHandler(bridgeKeeper, keeper, bankKeeper, cdc, types.DefaultCodespace)
+
+	res := handler(ctx, types.CreateTestEthMsg(t, accAddressVal2Pow7))
+	require.True(t, res.IsOK())
+	require.Equal(t, res.Log, oracle.SuccessStatus)
+	receiverAddress, err := sdk.AccAddressFromBech32(types.TestAddress)
+	require.NoError(t, err)
+	expectedCoins, err := sdk.ParseCoins(types.TestCoins)
+	require.NoError(t, err)
+	require.True(t, bankKeeper.GetCoins(ctx, receiverAddress).IsEqual(expectedCoins))
+
+	//A single pending revocation does not claw anything back
+	res = handler(ctx, types.CreateTestRevocationMsg(t, accAddressVal1Pow2, types.RevocationReasonUnlock))
+	require.True(t, res.IsOK())
+	require.Equal(t, res.Log, oracle.PendingStatus)
+	require.True(t, bankKeeper.GetCoins(ctx, receiverAddress).IsEqual(expectedCoins))
+
+	//Once the revocation succeeds the minted coins are clawed back
+	res = handler(ctx, types.CreateTestRevocationMsg(t, accAddressVal2Pow7, types.RevocationReasonUnlock))
+	require.True(t, res.IsOK())
+	require.Equal(t, res.Log, oracle.SuccessStatus)
+	require.True(t, bankKeeper.GetCoins(ctx, receiverAddress).IsZero())
+}
+
+func TestRevokeAfterSpend(t *testing.T) {
+	//Setup
+	cdc := codec.New()
+	ctx, bridgeKeeper, keeper, bankKeeper, validatorAddresses := bridgeKeeperLib.CreateTestKeepers(t, 0.7, []int64{3, 7})
+	accAddressVal2Pow7 := sdk.AccAddress(validatorAddresses[1])
+
+	handler := NewHandler(bridgeKeeper, keeper, bankKeeper, cdc, types.DefaultCodespace)
+
+	res := handler(ctx, types.CreateTestEthMsg(t, accAddressVal2Pow7))
+	require.True(t, res.IsOK())
+	receiverAddress, err := sdk.AccAddressFromBech32(types.TestAddress)
+	require.NoError(t, err)
+
+	//The receiver has already moved part of the minted coins on
+	spent, err := sdk.ParseCoins("4ethereum")
+	require.NoError(t, err)
+	_, _, err = bankKeeper.SubtractCoins(ctx, receiverAddress, spent)
+	require.NoError(t, err)
+
+	//Only what is still held is clawed back
+	res = handler(ctx, types.CreateTestRevocationMsg(t, accAddressVal2Pow7, types.RevocationReasonWithdraw))
+	require.True(t, res.IsOK())
+	require.Equal(t, res.Log, oracle.SuccessStatus)
+	require.True(t, bankKeeper.GetCoins(ctx, receiverAddress).IsZero())
+
+	//The spent coins remain in the bridged supply, and the rest is burned from the module account
+	require.True(t, bridgeKeeper.GetBridgedSupply(ctx).IsEqual(spent))
+	require.Equal(t, "6ethereum", bridgeKeeper.GetBurned(ctx).String())
+	require.True(t, bankKeeper.GetCoins(ctx, types.ModuleAddress).IsZero())
+
+	//Revocations with an unknown reason are rejected
+	badRevokeMsg := types.CreateTestRevocationMsg(t, accAddressVal2Pow7, "stolen")
+	res = handler(ctx, badRevokeMsg)
+	require.False(t, res.IsOK())
+	require.True(t, strings.Contains(res.Log, "invalid revocation reason provided"))
+}
+
+func TestBridgeStatusClaims(t *testing.T) {
+	//Setup
+	cdc := codec.New()
+	ctx, bridgeKeeper, keeper, bankKeeper, validatorAddresses := bridgeKeeperLib.CreateTestKeepers(t, 0.7, []int64{3, 7})
+	accAddressVal1Pow3 := sdk.AccAddress(validatorAddresses[0])
+	accAddressVal2Pow7 := sdk.AccAddress(validatorAddresses[1])
+
+	handler := NewHandler(bridgeKeeper, keeper, bankKeeper, cdc, types.DefaultCodespace)
+	require.True(t, bridgeKeeper.GetBridgeStatus(ctx, types.TestEthereumChainID).Active)
+
+	//A single attestation of a pause leaves the status unchanged
+	pausedMsg := types.NewMsgMakeBridgeStatusClaim(types.NewBridgeStatusClaim(types.TestEthereumChainID, false, 100, accAddressVal1Pow3))
+	res := handler(ctx, pausedMsg)
+	require.True(t, res.IsOK())
+	require.Equal(t, res.Log, oracle.PendingStatus)
+	require.True(t, bridgeKeeper.GetBridgeStatus(ctx, types.TestEthereumChainID).Active)
+
+	//Once enough validators attest the pause it is stored
+	pausedMsg = types.NewMsgMakeBridgeStatusClaim(types.NewBridgeStatusClaim(types.TestEthereumChainID, false, 100, accAddressVal2Pow7))
+	res = handler(ctx, pausedMsg)
+	require.True(t, res.IsOK())
+	require.Equal(t, res.Log, oracle.SuccessStatus)
+	require.Equal(t, types.NewBridgeStatus(types.TestEthereumChainID, false, 100), bridgeKeeper.GetBridgeStatus(ctx, types.TestEthereumChainID))
+
+	//A later activation is stored
+	activatedMsg := types.NewMsgMakeBridgeStatusClaim(types.NewBridgeStatusClaim(types.TestEthereumChainID, true, 150, accAddressVal2Pow7))
+	res = handler(ctx, activatedMsg)
+	require.True(t, res.IsOK())
+	require.Equal(t, types.NewBridgeStatus(types.TestEthereumChainID, true, 150), bridgeKeeper.GetBridgeStatus(ctx, types.TestEthereumChainID))
+
+	//Attestations without an ethereum block are rejected
+	badMsg := types.NewMsgMakeBridgeStatusClaim(types.NewBridgeStatusClaim(types.TestEthereumChainID, false, 0, accAddressVal2Pow7))
+	res = handler(ctx, badMsg)
+	require.False(t, res.IsOK())
+	require.True(t, strings.Contains(res.Log, "invalid ethereum block number provided"))
+
+	//Attestations on another chain leave this chain's status unchanged
+	otherChainMsg := types.NewMsgMakeBridgeStatusClaim(types.NewBridgeStatusClaim(types.TestEthereumChainID+1, false, 200, accAddressVal2Pow7))
+	res = handler(ctx, otherChainMsg)
+	require.True(t, res.IsOK())
+	require.Equal(t, res.Log, oracle.SuccessStatus)
+	require.False(t, bridgeKeeper.GetBridgeStatus(ctx, types.TestEthereumChainID+1).Active)
+	require.True(t, bridgeKeeper.GetBridgeStatus(ctx, types.TestEthereumChainID).Active)
+}
+
+func TestLastFinalizedNonce(t *testing.T) {
+	//Setup
+	cdc := codec.New()
+	ctx, bridgeKeeper, keeper, bankKeeper, validatorAddresses := bridgeKeeperLib.CreateTestKeepers(t, 0.7, []int64{3, 7})
+	accAddressVal1Pow3 := sdk.AccAddress(validatorAddresses[0])
+	accAddressVal2Pow7 := sdk.AccAddress(validatorAddresses[1])
+	chainID := types.TestEthereumChainID
+	contract := types.TestBridgeContract
+
+	handler := NewHandler(bridgeKeeper, keeper, bankKeeper, cdc, types.DefaultCodespace)
+	nonceMsg := func(validator sdk.AccAddress, nonce uint64) MsgMakeEthBridgeClaim {
+		msg := types.CreateTestEthMsg(t, validator)
+		msg.Nonce = sdk.NewUint(nonce)
+		msg.ItemID = gethCommon.BigToHash(big.NewInt(int64(nonce))).Hex()
+		return msg
+	}
+
+	//Without sequential minting a lock is minted as soon as it succeeds, but the nonce before it is missing
+	res := handler(ctx, nonceMsg(accAddressVal2Pow7, 2))
+	require.True(t, res.IsOK())
+	require.Equal(t, oracle.SuccessStatus, res.Log)
+	receiverAddress, err := sdk.AccAddressFromBech32(types.TestAddress)
+	require.NoError(t, err)
+	require.Equal(t, "10ethereum", bankKeeper.GetCoins(ctx, receiverAddress).String())
+	require.True(t, bridgeKeeper.GetLastFinalizedNonce(ctx, chainID, contract).IsZero())
+
+	//A pending prophecy does not finalize its nonce
+	res = handler(ctx, nonceMsg(accAddressVal1Pow3, 1))
+	require.True(t, res.IsOK())
+	require.Equal(t, oracle.PendingStatus, res.Log)
+	require.True(t, bridgeKeeper.GetLastFinalizedNonce(ctx, chainID, contract).IsZero())
+
+	//Once it succeeds, the last finalized nonce moves past every nonce finalized after it
+	res = handler(ctx, nonceMsg(accAddressVal2Pow7, 1))
+	require.True(t, res.IsOK())
+	require.Equal(t, oracle.SuccessStatus, res.Log)
+	require.Equal(t, sdk.NewUint(2), bridgeKeeper.GetLastFinalizedNonce(ctx, chainID, contract))
+	require.Equal(t, "20ethereum", bankKeeper.GetCoins(ctx, receiverAddress).String())
+}
+
+func TestSequentialMinting(t *testing.T) {
+	//Setup
+	cdc := codec.New()
+	ctx, bridgeKeeper, keeper, bankKeeper, validatorAddresses := bridgeKeeperLib.CreateTestKeepers(t, 0.7, []int64{3, 7})
+	accAddressVal2Pow7 := sdk.AccAddress(validatorAddresses[1])
+	chainID := types.TestEthereumChainID
+	contract := types.TestBridgeContract
+	bridgeKeeper.SetParams(ctx, types.NewParams(true, nil, nil, 0, types.MintLimits{}, nil, 0))
+
+	handler := NewHandler(bridgeKeeper, keeper, bankKeeper, cdc, types.DefaultCodespace)
+	nonceMsg := func(nonce uint64) MsgMakeEthBridgeClaim {
+		msg := types.CreateTestEthMsg(t, accAddressVal2Pow7)
+		msg.Nonce = sdk.NewUint(nonce)
+		msg.ItemID = gethCommon.BigToHash(big.NewInt(int64(nonce))).Hex()
+		return msg
+	}
+	receiverAddress, err := sdk.AccAddressFromBech32(types.TestAddress)
+	require.NoError(t, err)
+
+	//A lock which succeeds before the lock on the previous nonce is queued
+	res := handler(ctx, nonceMsg(2))
+	require.True(t, res.IsOK())
+	require.Equal(t, oracle.SuccessStatus, res.Log)
+	require.True(t, bankKeeper.GetCoins(ctx, receiverAddress).IsZero())
+	_, ok := bridgeKeeper.GetQueuedMint(ctx, chainID, contract, sdk.NewUint(2))
+	require.True(t, ok)
+
+	//It is minted after the lock on the previous nonce
+	res = handler(ctx, nonceMsg(1))
+	require.True(t, res.IsOK())
+	require.Equal(t, oracle.SuccessStatus, res.Log)
+	require.Equal(t, "20ethereum", bankKeeper.GetCoins(ctx, receiverAddress).String())
+	require.Equal(t, sdk.NewUint(2), bridgeKeeper.GetLastFinalizedNonce(ctx, chainID, contract))
+	_, ok = bridgeKeeper.GetQueuedMint(ctx, chainID, contract, sdk.NewUint(2))
+	require.False(t, ok)
+
+	//A queued lock which is revoked is dropped without clawing back coins it never minted
+	queuedMsg := nonceMsg(4)
+	res = handler(ctx, queuedMsg)
+	require.True(t, res.IsOK())
+	revocation := types.NewEthBridgeRevocation(chainID, contract, queuedMsg.ItemID, queuedMsg.Nonce, queuedMsg.EthereumSender,
+		accAddressVal2Pow7, types.RevocationReasonWithdraw)
+	res = handler(ctx, types.NewMsgRevokeEthBridgeClaim(revocation))
+	require.True(t, res.IsOK())
+	require.Equal(t, oracle.SuccessStatus, res.Log)
+	_, ok = bridgeKeeper.GetQueuedMint(ctx, chainID, contract, sdk.NewUint(4))
+	require.False(t, ok)
+	require.Equal(t, "20ethereum", bankKeeper.GetCoins(ctx, receiverAddress).String())
+
+	//The revoked nonce is finalized along with the one before it, but mints nothing
+	res = handler(ctx, nonceMsg(3))
+	require.True(t, res.IsOK())
+	require.Equal(t, "30ethereum", bankKeeper.GetCoins(ctx, receiverAddress).String())
+	require.Equal(t, sdk.NewUint(4), bridgeKeeper.GetLastFinalizedNonce(ctx, chainID, contract))
+}
+
+func TestOutOfOrderNonces(t *testing.T) {
+	//Setup
+	cdc := codec.New()
+	ctx, bridgeKeeper, keeper, bankKeeper, validatorAddresses := bridgeKeeperLib.CreateTestKeepers(t, 0.7, []int64{3, 7})
+	accAddressVal1Pow3 := sdk.AccAddress(validatorAddresses[0])
+	accAddressVal2Pow7 := sdk.AccAddress(validatorAddresses[1])
+	chainID := types.TestEthereumChainID
+	contract := types.TestBridgeContract
+	bridgeKeeper.SetParams(ctx, types.NewParams(true, nil, nil, 0, types.MintLimits{}, nil, 0))
+
+	handler := NewHandler(bridgeKeeper, keeper, bankKeeper, cdc, types.DefaultCodespace)
+	secondMsg := func(validator sdk.AccAddress) MsgMakeEthBridgeClaim {
+		msg := types.CreateTestEthMsg(t, validator)
+		msg.ItemID = types.AltTestEthereumItemID
+		msg.Nonce = sdk.NewUint(types.TestNonce + 1)
+		return msg
+	}
+	receiverAddress, err := sdk.AccAddressFromBech32(types.TestAddress)
+	require.NoError(t, err)
+
+	//The contract's first nonce is not finalized before any lock is claimed on it
+	require.True(t, bridgeKeeper.GetLastFinalizedNonce(ctx, chainID, contract).IsZero())
+
+	//The lock on the second nonce is relayed first, and is queued once it succeeds
+	res := handler(ctx, secondMsg(accAddressVal1Pow3))
+	require.True(t, res.IsOK())
+	require.Equal(t, oracle.PendingStatus, res.Log)
+	res = handler(ctx, secondMsg(accAddressVal2Pow7))
+	require.True(t, res.IsOK())
+	require.Equal(t, oracle.SuccessStatus, res.Log)
+	require.True(t, bankKeeper.GetCoins(ctx, receiverAddress).IsZero())
+	_, ok := bridgeKeeper.GetQueuedMint(ctx, chainID, contract, sdk.NewUint(types.TestNonce+1))
+	require.True(t, ok)
+	require.True(t, bridgeKeeper.GetLastFinalizedNonce(ctx, chainID, contract).IsZero())
+
+	//Once the lock on the first nonce succeeds both are minted in order
+	res = handler(ctx, types.CreateTestEthMsg(t, accAddressVal1Pow3))
+	require.True(t, res.IsOK())
+	require.Equal(t, oracle.PendingStatus, res.Log)
+	require.True(t, bankKeeper.GetCoins(ctx, receiverAddress).IsZero())
+	res = handler(ctx, types.CreateTestEthMsg(t, accAddressVal2Pow7))
+	require.True(t, res.IsOK())
+	require.Equal(t, oracle.SuccessStatus, res.Log)
+	require.Equal(t, "20ethereum", bankKeeper.GetCoins(ctx, receiverAddress).String())
+	require.Equal(t, sdk.NewUint(types.TestNonce+1), bridgeKeeper.GetLastFinalizedNonce(ctx, chainID, contract))
+	_, ok = bridgeKeeper.GetQueuedMint(ctx, chainID, contract, sdk.NewUint(types.TestNonce+1))
+	require.False(t, ok)
+}
+
+func TestPauseMinting(t *testing.T) {
+	//Setup
+	cdc := codec.New()
+	ctx, bridgeKeeper, keeper, bankKeeper, validatorAddresses := bridgeKeeperLib.CreateTestKeepers(t, 0.7, []int64{3, 7})
+	accAddressVal1Pow3 := sdk.AccAddress(validatorAddresses[0])
+	accAddressVal2Pow7 := sdk.AccAddress(validatorAddresses[1])
+	chainID := types.TestEthereumChainID
+	contract := types.TestBridgeContract
+
+	handler := NewHandler(bridgeKeeper, keeper, bankKeeper, cdc, types.DefaultCodespace)
+	nonceMsg := func(nonce uint64) MsgMakeEthBridgeClaim {
+		msg := types.CreateTestEthMsg(t, accAddressVal2Pow7)
+		msg.Nonce = sdk.NewUint(nonce)
+		msg.ItemID = gethCommon.BigToHash(big.NewInt(int64(nonce))).Hex()
+		return msg
+	}
+	receiverAddress, err := sdk.AccAddressFromBech32(types.TestAddress)
+	require.NoError(t, err)
+
+	//Minting cannot be paused without an admin, nor by anyone but the admin
+	res := handler(ctx, types.NewMsgSetBridgeStatus(true, accAddressVal1Pow3))
+	require.False(t, res.IsOK())
+	require.Equal(t, types.CodeUnauthorizedAdmin, res.Code)
+	bridgeKeeper.SetParams(ctx, types.NewParams(false, accAddressVal1Pow3, nil, 0, types.MintLimits{}, nil, 0))
+	res = handler(ctx, types.NewMsgSetBridgeStatus(true, accAddressVal2Pow7))
+	require.False(t, res.IsOK())
+	require.False(t, bridgeKeeper.IsMintingPaused(ctx))
+
+	res = handler(ctx, types.NewMsgSetBridgeStatus(true, accAddressVal1Pow3))
+	require.True(t, res.IsOK())
+	require.Equal(t, types.MintingStatusPaused, res.Log)
+	require.True(t, bridgeKeeper.IsMintingPaused(ctx))
+
+	//Claims are still processed while paused, but the coins of successful prophecies are held
+	res = handler(ctx, nonceMsg(1))
+	require.True(t, res.IsOK())
+	require.Equal(t, oracle.SuccessStatus, res.Log)
+	res = handler(ctx, nonceMsg(2))
+	require.True(t, res.IsOK())
+	require.True(t, bankKeeper.GetCoins(ctx, receiverAddress).IsZero())
+	require.True(t, bridgeKeeper.GetBridgedSupply(ctx).IsZero())
+	_, ok := bridgeKeeper.GetPausedMint(ctx, chainID, contract, sdk.NewUint(1))
+	require.True(t, ok)
+	require.Equal(t, sdk.NewUint(2), bridgeKeeper.GetLastFinalizedNonce(ctx, chainID, contract))
+
+	//A held lock which is revoked is dropped without clawing back coins it never minted
+	revokedMsg := nonceMsg(2)
+	revocation := types.NewEthBridgeRevocation(chainID, contract, revokedMsg.ItemID, revokedMsg.Nonce, revokedMsg.EthereumSender,
+		accAddressVal2Pow7, types.RevocationReasonWithdraw)
+	res = handler(ctx, types.NewMsgRevokeEthBridgeClaim(revocation))
+	require.True(t, res.IsOK())
+	require.Equal(t, oracle.SuccessStatus, res.Log)
+	_, ok = bridgeKeeper.GetPausedMint(ctx, chainID, contract, sdk.NewUint(2))
+	require.False(t, ok)
+
+	//Resuming mints the coins still held
+	res = handler(ctx, types.NewMsgSetBridgeStatus(false, accAddressVal1Pow3))
+	require.True(t, res.IsOK())
+	require.Equal(t, types.MintingStatusActive, res.Log)
+	require.False(t, bridgeKeeper.IsMintingPaused(ctx))
+	require.Equal(t, "10ethereum", bankKeeper.GetCoins(ctx, receiverAddress).String())
+	require.Equal(t, "10ethereum", bridgeKeeper.GetBridgedSupply(ctx).String())
+	_, ok = bridgeKeeper.GetPausedMint(ctx, chainID, contract, sdk.NewUint(1))
+	require.False(t, ok)
+
+	//Once resumed, successful prophecies mint straight away
+	res = handler(ctx, nonceMsg(3))
+	require.True(t, res.IsOK())
+	require.Equal(t, "20ethereum", bankKeeper.GetCoins(ctx, receiverAddress).String())
+}
+
+func TestBridgeFee(t *testing.T) {
+	//Setup
+	cdc := codec.New()
+	ctx, bridgeKeeper, keeper, bankKeeper, validatorAddresses := bridgeKeeperLib.CreateTestKeepers(t, 0.7, []int64{3, 7})
+	accAddressVal1Pow3 := sdk.AccAddress(validatorAddresses[0])
+	accAddressVal2Pow7 := sdk.AccAddress(validatorAddresses[1])
+
+	handler := NewHandler(bridgeKeeper, keeper, bankKeeper, cdc, types.DefaultCodespace)
+	bridgeKeeper.SetParams(ctx, types.NewParams(false, nil, sdk.Coins{sdk.NewInt64Coin("ethereum", 1)}, 4000, types.MintLimits{}, nil, 0))
+	receiverAddress, err := sdk.AccAddressFromBech32(types.TestAddress)
+	require.NoError(t, err)
+
+	//A fee of 1ethereum plus 40% is deducted from the 10ethereum minted
+	res := handler(ctx, types.CreateTestEthMsg(t, accAddressVal1Pow3))
+	require.True(t, res.IsOK())
+	res = handler(ctx, types.CreateTestEthMsg(t, accAddressVal2Pow7))
+	require.True(t, res.IsOK())
+	require.Equal(t, oracle.SuccessStatus, res.Log)
+	require.Equal(t, "5ethereum", bankKeeper.GetCoins(ctx, receiverAddress).String())
+	require.Equal(t, "10ethereum", bridgeKeeper.GetMinted(ctx).String())
+	require.Equal(t, "5ethereum", bankKeeper.GetCoins(ctx, types.ModuleAddress).String())
+
+	//The fee is split between the validators who relayed the lock by power, and the rounding is kept for the next fee
+	require.Equal(t, "1ethereum", bridgeKeeper.GetRelayerRewards(ctx, accAddressVal1Pow3).String())
+	require.Equal(t, "3ethereum", bridgeKeeper.GetRelayerRewards(ctx, accAddressVal2Pow7).String())
+	require.Equal(t, "1ethereum", bridgeKeeper.GetUndistributedFees(ctx).String())
+
+	//A validator withdraws its rewards once
+	validatorCoins := bankKeeper.GetCoins(ctx, accAddressVal2Pow7)
+	res = handler(ctx, types.NewMsgWithdrawRelayerRewards(accAddressVal2Pow7))
+	require.True(t, res.IsOK())
+	require.Equal(t, "3ethereum", res.Log)
+	require.True(t, bankKeeper.GetCoins(ctx, accAddressVal2Pow7).IsEqual(validatorCoins.Add(sdk.Coins{sdk.NewInt64Coin("ethereum", 3)})))
+	require.True(t, bridgeKeeper.GetRelayerRewards(ctx, accAddressVal2Pow7).IsZero())
+	res = handler(ctx, types.NewMsgWithdrawRelayerRewards(accAddressVal2Pow7))
+	require.False(t, res.IsOK())
+	require.Equal(t, types.CodeNoRelayerRewards, res.Code)
+
+	//A revocation only claws back what the receiver was sent, and the fee stays with the validators
+	res = handler(ctx, types.CreateTestRevocationMsg(t, accAddressVal2Pow7, types.RevocationReasonWithdraw))
+	require.True(t, res.IsOK())
+	require.True(t, bankKeeper.GetCoins(ctx, receiverAddress).IsZero())
+	require.Equal(t, "5ethereum", bridgeKeeper.GetBurned(ctx).String())
+	require.Equal(t, "2ethereum", bankKeeper.GetCoins(ctx, types.ModuleAddress).String())
+	require.Equal(t, "1ethereum", bridgeKeeper.GetRelayerRewards(ctx, accAddressVal1Pow3).String())
+}
+
+func TestMintLimits(t *testing.T) {
+	//Setup
+	cdc := codec.New()
+	ctx, bridgeKeeper, keeper, bankKeeper, validatorAddresses := bridgeKeeperLib.CreateTestKeepers(t, 0.7, []int64{3, 7})
+	accAddressVal1Pow3 := sdk.AccAddress(validatorAddresses[0])
+	accAddressVal2Pow7 := sdk.AccAddress(validatorAddresses[1])
+	chainID := types.TestEthereumChainID
+	contract := types.TestBridgeContract
+
+	handler := NewHandler(bridgeKeeper, keeper, bankKeeper, cdc, types.DefaultCodespace)
+	nonceMsg := func(nonce uint64) MsgMakeEthBridgeClaim {
+		msg := types.CreateTestEthMsg(t, accAddressVal2Pow7)
+		msg.Nonce = sdk.NewUint(nonce)
+		msg.ItemID = gethCommon.BigToHash(big.NewInt(int64(nonce))).Hex()
+		return msg
+	}
+	receiverAddress, err := sdk.AccAddressFromBech32(types.TestAddress)
+	require.NoError(t, err)
+
+	//Only the admin sets the mint limits
+	bridgeKeeper.SetParams(ctx, types.NewParams(false, accAddressVal1Pow3, nil, 0, types.MintLimits{}, nil, 0))
+	mintLimits := types.NewMintLimits(2, sdk.Coins{sdk.NewInt64Coin("ethereum", 25)}, sdk.Coins{sdk.NewInt64Coin("ethereum", 15)})
+	res := handler(ctx, types.NewMsgSetMintLimits(mintLimits, accAddressVal2Pow7))
+	require.False(t, res.IsOK())
+	require.Equal(t, types.CodeUnauthorizedAdmin, res.Code)
+	res = handler(ctx, types.NewMsgSetMintLimits(mintLimits, accAddressVal1Pow3))
+	require.True(t, res.IsOK())
+	require.Equal(t, mintLimits, bridgeKeeper.GetParams(ctx).MintLimits())
+
+	//A mint which would take the receiver over its limit is delayed
+	ctx = ctx.WithBlockHeight(1)
+	res = handler(ctx, nonceMsg(1))
+	require.True(t, res.IsOK())
+	res = handler(ctx, nonceMsg(2))
+	require.True(t, res.IsOK())
+	require.Equal(t, oracle.SuccessStatus, res.Log)
+	require.Equal(t, "10ethereum", bankKeeper.GetCoins(ctx, receiverAddress).String())
+	delayedMint, ok := bridgeKeeper.GetDelayedMint(ctx, chainID, contract, sdk.NewUint(2))
+	require.True(t, ok)
+	require.Equal(t, int64(1), delayedMint.DelayedAt)
+
+	//It is released by the end blocker once the earlier mint has left the window
+	EndBlocker(ctx, bridgeKeeper, keeper, bankKeeper)
+	EndBlocker(ctx.WithBlockHeight(2), bridgeKeeper, keeper, bankKeeper)
+	require.Equal(t, "10ethereum", bankKeeper.GetCoins(ctx, receiverAddress).String())
+	ctx = ctx.WithBlockHeight(3)
+	EndBlocker(ctx, bridgeKeeper, keeper, bankKeeper)
+	require.Equal(t, "20ethereum", bankKeeper.GetCoins(ctx, receiverAddress).String())
+	_, ok = bridgeKeeper.GetDelayedMint(ctx, chainID, contract, sdk.NewUint(2))
+	require.False(t, ok)
+
+	//A delayed lock which is revoked is dropped without clawing back coins it never minted
+	res = handler(ctx, nonceMsg(3))
+	require.True(t, res.IsOK())
+	_, ok = bridgeKeeper.GetDelayedMint(ctx, chainID, contract, sdk.NewUint(3))
+	require.True(t, ok)
+	revokedMsg := nonceMsg(3)
+	revocation := types.NewEthBridgeRevocation(chainID, contract, revokedMsg.ItemID, revokedMsg.Nonce, revokedMsg.EthereumSender,
+		accAddressVal2Pow7, types.RevocationReasonWithdraw)
+	res = handler(ctx, types.NewMsgRevokeEthBridgeClaim(revocation))
+	require.True(t, res.IsOK())
+	_, ok = bridgeKeeper.GetDelayedMint(ctx, chainID, contract, sdk.NewUint(3))
+	require.False(t, ok)
+	require.Equal(t, "20ethereum", bankKeeper.GetCoins(ctx, receiverAddress).String())
+
+	//A mint larger than a limit on its own is released after a whole window, even if other mints were made in it
+	mintLimits = types.NewMintLimits(2, sdk.Coins{sdk.NewInt64Coin("ethereum", 25)}, sdk.Coins{sdk.NewInt64Coin("ethereum", 5)})
+	res = handler(ctx, types.NewMsgSetMintLimits(mintLimits, accAddressVal1Pow3))
+	require.True(t, res.IsOK())
+	ctx = ctx.WithBlockHeight(10)
+	res = handler(ctx, nonceMsg(4))
+	require.True(t, res.IsOK())
+	bridgeKeeper.RecordWindowMint(ctx.WithBlockHeight(11), accAddressVal1Pow3, sdk.Coins{sdk.NewInt64Coin("ethereum", 1)})
+	EndBlocker(ctx.WithBlockHeight(11), bridgeKeeper, keeper, bankKeeper)
+	require.Equal(t, "20ethereum", bankKeeper.GetCoins(ctx, receiverAddress).String())
+	EndBlocker(ctx.WithBlockHeight(12), bridgeKeeper, keeper, bankKeeper)
+	require.Equal(t, "30ethereum", bankKeeper.GetCoins(ctx, receiverAddress).String())
+	_, ok = bridgeKeeper.GetDelayedMint(ctx, chainID, contract, sdk.NewUint(4))
+	require.False(t, ok)
+}
+
+func TestEscrowedMints(t *testing.T) {
+	//Setup
+	cdc := codec.New()
+	ctx, bridgeKeeper, keeper, bankKeeper, validatorAddresses := bridgeKeeperLib.CreateTestKeepers(t, 0.7, []int64{3, 7})
+	accAddressVal1Pow3 := sdk.AccAddress(validatorAddresses[0])
+	accAddressVal2Pow7 := sdk.AccAddress(validatorAddresses[1])
+	chainID := types.TestEthereumChainID
+	contract := types.TestBridgeContract
+
+	handler := NewHandler(bridgeKeeper, keeper, bankKeeper, cdc, types.DefaultCodespace)
+	nonceMsg := func(nonce uint64) MsgMakeEthBridgeClaim {
+		msg := types.CreateTestEthMsg(t, accAddressVal2Pow7)
+		msg.Nonce = sdk.NewUint(nonce)
+		msg.ItemID = gethCommon.BigToHash(big.NewInt(int64(nonce))).Hex()
+		return msg
+	}
+	receiverAddress, err := sdk.AccAddressFromBech32(types.TestAddress)
+	require.NoError(t, err)
+	bridgeKeeper.SetParams(ctx, types.NewParams(false, nil, nil, 0, types.MintLimits{}, sdk.Coins{sdk.NewInt64Coin("ethereum", 5)}, 3))
+
+	//A mint above the threshold is held in escrow
+	ctx = ctx.WithBlockHeight(1)
+	res := handler(ctx, nonceMsg(1))
+	require.True(t, res.IsOK())
+	require.Equal(t, oracle.SuccessStatus, res.Log)
+	require.True(t, bankKeeper.GetCoins(ctx, receiverAddress).IsZero())
+	escrowedMint, ok := bridgeKeeper.GetEscrowedMint(ctx, chainID, contract, sdk.NewUint(1))
+	require.True(t, ok)
+	require.Equal(t, int64(4), escrowedMint.ReleaseHeight)
+
+	//Disputes must name the nonce of the escrowed mint
+	noNonceDispute := types.NewMsgDisputeEscrowedMint(chainID, contract, sdk.Uint{}, accAddressVal1Pow3)
+	require.Equal(t, types.CodeInvalidEthNonce, noNonceDispute.ValidateBasic().Code())
+	res = handler(ctx, noNonceDispute)
+	require.False(t, res.IsOK())
+	require.Equal(t, types.CodeInvalidEthNonce, res.Code)
+
+	//Only bonded validators dispute it, once each
+	res = handler(ctx, types.NewMsgDisputeEscrowedMint(chainID, contract, sdk.NewUint(1), receiverAddress))
+	require.False(t, res.IsOK())
+	require.Equal(t, types.CodeInvalidDispute, res.Code)
+	res = handler(ctx, types.NewMsgDisputeEscrowedMint(chainID, contract, sdk.NewUint(1), accAddressVal1Pow3))
+	require.True(t, res.IsOK())
+	require.Equal(t, types.EscrowStatusDisputed, res.Log)
+	res = handler(ctx, types.NewMsgDisputeEscrowedMint(chainID, contract, sdk.NewUint(1), accAddressVal1Pow3))
+	require.False(t, res.IsOK())
+	require.Equal(t, types.CodeInvalidDispute, res.Code)
+
+	//Without enough power disputing it, it is released by the end blocker at its release height
+	EndBlocker(ctx.WithBlockHeight(3), bridgeKeeper, keeper, bankKeeper)
+	require.True(t, bankKeeper.GetCoins(ctx, receiverAddress).IsZero())
+	ctx = ctx.WithBlockHeight(4)
+	EndBlocker(ctx, bridgeKeeper, keeper, bankKeeper)
+	require.Equal(t, "10ethereum", bankKeeper.GetCoins(ctx, receiverAddress).String())
+	_, ok = bridgeKeeper.GetEscrowedMint(ctx, chainID, contract, sdk.NewUint(1))
+	require.False(t, ok)
+
+	//Disputes backed by enough power cancel it and revoke its prophecy
+	res = handler(ctx, nonceMsg(2))
+	require.True(t, res.IsOK())
+	res = handler(ctx, types.NewMsgDisputeEscrowedMint(chainID, contract, sdk.NewUint(2), accAddressVal2Pow7))
+	require.True(t, res.IsOK())
+	require.Equal(t, types.EscrowStatusCancelled, res.Log)
+	_, ok = bridgeKeeper.GetEscrowedMint(ctx, chainID, contract, sdk.NewUint(2))
+	require.False(t, ok)
+	prophecy, err := keeper.GetProphecy(ctx, string(nonceMsg(2).ProphecyID().Key()))
+	require.NoError(t, err)
+	require.Equal(t, oracle.RevokedStatus, prophecy.Status.StatusText)
+	EndBlocker(ctx.WithBlockHeight(7), bridgeKeeper, keeper, bankKeeper)
+	require.Equal(t, "10ethereum", bankKeeper.GetCoins(ctx, receiverAddress).String())
+	res = handler(ctx, types.NewMsgDisputeEscrowedMint(chainID, contract, sdk.NewUint(2), accAddressVal1Pow3))
+	require.False(t, res.IsOK())
+	require.Equal(t, types.CodeEscrowNotFound, res.Code)
+
+	//A revocation of the cancelled lock still succeeds, without clawing anything back
+	cancelledMsg := nonceMsg(2)
+	revocation := types.NewEthBridgeRevocation(chainID, contract, cancelledMsg.ItemID, cancelledMsg.Nonce, cancelledMsg.EthereumSender,
+		accAddressVal2Pow7, types.RevocationReasonUnlock)
+	res = handler(ctx, types.NewMsgRevokeEthBridgeClaim(revocation))
+	require.True(t, res.IsOK())
+	require.Equal(t, oracle.SuccessStatus, res.Log)
+	require.Equal(t, "10ethereum", bankKeeper.GetCoins(ctx, receiverAddress).String())
+
+	//A mint at or below the threshold is minted straight away
+	smallMsg := nonceMsg(3)
+	smallMsg.Amount = sdk.Coins{sdk.NewInt64Coin("ethereum", 5)}
+	res = handler(ctx, smallMsg)
+	require.True(t, res.IsOK())
+	require.Equal(t, "15ethereum", bankKeeper.GetCoins(ctx, receiverAddress).String())
+}
+
+func TestInvalidReceiverClaims(t *testing.T) {
+	//Setup
+	cdc := codec.New()
+	ctx, bridgeKeeper, keeper, bankKeeper, validatorAddresses := bridgeKeeperLib.CreateTestKeepers(t, 0.7, []int64{3, 7})
+	accAddressVal1Pow3 := sdk.AccAddress(validatorAddresses[0])
+	accAddressVal2Pow7 := sdk.AccAddress(validatorAddresses[1])
+
+	handler := NewHandler(bridgeKeeper, keeper, bankKeeper, cdc, types.DefaultCodespace)
+	receiverAddress, err := sdk.AccAddressFromBech32(types.TestAddress)
+	require.NoError(t, err)
+
+	//Claims must either give a cosmos receiver or an invalid one
+	badMsg := types.CreateTestEthMsg(t, accAddressVal1Pow3)
+	badMsg.InvalidReceiver = types.TestInvalidReceiver
+	res := handler(ctx, badMsg)
+	require.False(t, res.IsOK())
+	require.Equal(t, types.CodeInvalidReceiverClaim, res.Code)
+	badInvalidReceiverMsg := types.CreateTestInvalidReceiverMsg(t, accAddressVal1Pow3)
+	badInvalidReceiverMsg.CosmosReceiver = receiverAddress
+	res = handler(ctx, badInvalidReceiverMsg)
+	require.False(t, res.IsOK())
+	require.Equal(t, types.CodeInvalidReceiverClaim, res.Code)
+
+	//Invalid receiver claims are made on the same prophecy as the lock's other claims
+	res = handler(ctx, types.CreateTestEthMsg(t, accAddressVal1Pow3))
+	require.True(t, res.IsOK())
+	require.Equal(t, oracle.PendingStatus, res.Log)
+	res = handler(ctx, types.CreateTestInvalidReceiverMsg(t, accAddressVal2Pow7))
+	require.True(t, res.IsOK())
+	require.Equal(t, oracle.SuccessStatus, res.Log)
+
+	//Once the invalid receiver claim succeeds nothing is minted, and the lock is refundable to its ethereum sender
+	require.True(t, bankKeeper.GetCoins(ctx, receiverAddress).IsZero())
+	require.True(t, bridgeKeeper.GetMinted(ctx).IsZero())
+	require.Empty(t, bridgeKeeper.GetRefunds(ctx, types.AltTestEthereumAddress))
+	refunds := bridgeKeeper.GetRefunds(ctx, types.TestEthereumAddress)
+	require.Len(t, refunds, 1)
+	require.Equal(t, types.RefundStatusRefundable, refunds[0].Status)
+	require.Equal(t, types.TestInvalidReceiver, refunds[0].InvalidReceiver)
+	require.Equal(t, types.TestCoins, refunds[0].Amount.String())
+	require.Equal(t, types.TestEthereumItemID, refunds[0].ProphecyID.ItemID)
+
+	//Unlocking the lock on ethereum marks it as refunded without clawing anything back
+	res = handler(ctx, types.CreateTestRevocationMsg(t, accAddressVal2Pow7, types.RevocationReasonUnlock))
+	require.True(t, res.IsOK())
+	require.Equal(t, oracle.SuccessStatus, res.Log)
+	refunds = bridgeKeeper.GetRefunds(ctx, types.TestEthereumAddress)
+	require.Len(t, refunds, 1)
+	require.Equal(t, types.RefundStatusRefunded, refunds[0].Status)
+	require.True(t, bridgeKeeper.GetMinted(ctx).IsZero())
+}
@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/swishlabsco/cosmos-ethereum-bridge/x/ethbridge/types"
+)
+
+// SetEscrowedMint holds the mint of a successful prophecy in escrow until its release height
+func (k Keeper) SetEscrowedMint(ctx sdk.Context, escrowedMint types.EscrowedMint) {
+	store := ctx.KVStore(k.storeKey)
+	id := escrowedMint.NonceProphecy.ProphecyID
+	key := types.GetEscrowedMintKey(id.EthereumChainID, id.BridgeContractAddress, escrowedMint.NonceProphecy.Nonce)
+	store.Set(key, k.cdc.MustMarshalBinaryBare(escrowedMint))
+}
+
+// GetEscrowedMint returns the mint held in escrow on a nonce of a bridge contract, or false if there is none
+func (k Keeper) GetEscrowedMint(ctx sdk.Context, ethereumChainID int, bridgeContractAddress string, nonce sdk.Uint) (types.EscrowedMint, bool) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetEscrowedMintKey(ethereumChainID, bridgeContractAddress, nonce)
+	if !store.Has(key) {
+		return types.EscrowedMint{}, false
+	}
+	var escrowedMint types.EscrowedMint
+	k.cdc.MustUnmarshalBinaryBare(store.Get(key), &escrowedMint)
+	return escrowedMint, true
+}
+
+// DeleteEscrowedMint removes the mint held in escrow on a nonce of a bridge contract
+func (k Keeper) DeleteEscrowedMint(ctx sdk.Context, ethereumChainID int, bridgeContractAddress string, nonce sdk.Uint) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetEscrowedMintKey(ethereumChainID, bridgeContractAddress, nonce))
+}
+
+// IterateEscrowedMints calls cb on the escrowed mints of every bridge contract, in order of their nonces within each
+// contract, until cb returns true
+func (k Keeper) IterateEscrowedMints(ctx sdk.Context, cb func(escrowedMint types.EscrowedMint) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.EscrowedMintKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var escrowedMint types.EscrowedMint
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &escrowedMint)
+		if cb(escrowedMint) {
+			return
+		}
+	}
+}
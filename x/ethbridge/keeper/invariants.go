@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+
+	"github.com/swishlabsco/cosmos-ethereum-bridge/x/ethbridge/types"
+)
+
+// SupplyInvariant checks that the bridged supply of each denom the bridge has minted equals the coins of that
+// denom held by accounts and collected as fees, so that no coins of a bridged denom exist which the bridge did
+// not mint
+func SupplyInvariant(k Keeper, ak auth.AccountKeeper, fck auth.FeeCollectionKeeper) sdk.Invariant {
+	return func(ctx sdk.Context) error {
+		bridged := make(map[string]sdk.Int)
+		var denoms []string
+		k.IterateBridgedSupply(ctx, func(denom string, amount sdk.Int) bool {
+			bridged[denom] = amount
+			denoms = append(denoms, denom)
+			return false
+		})
+		if len(denoms) == 0 {
+			return nil
+		}
+
+		held := GetHeldCoins(ctx, ak, fck)
+		var broken []string
+		for _, denom := range denoms {
+			if amount := held.AmountOf(denom); !amount.Equal(bridged[denom]) {
+				broken = append(broken, fmt.Sprintf("%s: bridged %s, held %s", denom, bridged[denom], amount))
+			}
+		}
+		if len(broken) > 0 {
+			return fmt.Errorf("bridged supply does not match the coins held: %s", strings.Join(broken, "; "))
+		}
+		return nil
+	}
+}
+
+// GetHeldCoins returns the coins held by all accounts and collected as fees
+func GetHeldCoins(ctx sdk.Context, ak auth.AccountKeeper, fck auth.FeeCollectionKeeper) sdk.Coins {
+	held := fck.GetCollectedFees(ctx)
+	ak.IterateAccounts(ctx, func(acc auth.Account) bool {
+		held = held.Add(acc.GetCoins())
+		return false
+	})
+	return held
+}
+
+// RelayerRewardsInvariant checks that the ethbridge module account holds at least the relayer rewards which have
+// not been withdrawn and the bridge fees left over by rounding. It can hold more, since anyone can send coins to it;
+// those are kept apart from the relayer fees and never paid out as rewards.
+func RelayerRewardsInvariant(k Keeper, ak auth.AccountKeeper) sdk.Invariant {
+	return func(ctx sdk.Context) error {
+		owed := k.GetOwedRelayerFees(ctx)
+
+		held := sdk.Coins{}
+		if acc := ak.GetAccount(ctx, types.ModuleAddress); acc != nil {
+			held = acc.GetCoins()
+		}
+		if !held.IsAllGTE(owed) {
+			return fmt.Errorf("ethbridge module account holds %s, less than the %s owed to relayers", held, owed)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/stretchr/testify/require"
+
+	"github.com/swishlabsco/cosmos-ethereum-bridge/x/ethbridge/types"
+	oracleKeeperLib "github.com/swishlabsco/cosmos-ethereum-bridge/x/oracle/keeper"
+)
+
+func TestSupplyInvariant(t *testing.T) {
+	keyEthBridge := sdk.NewKVStoreKey(types.StoreKey)
+	keyFeeCollection := sdk.NewKVStoreKey(auth.FeeStoreKey)
+	ctx, accountKeeper, _, bankKeeper, paramsKeeper, _, err := oracleKeeperLib.CreateTestKeepersWithParams(t, 0.7, []int64{3, 7}, keyEthBridge, keyFeeCollection)
+	require.Nil(t, err)
+	cdc := oracleKeeperLib.MakeTestCodec()
+	keeper := NewKeeper(keyEthBridge, cdc, paramsKeeper.Subspace(types.DefaultParamspace), types.DefaultCodespace)
+	feeCollectionKeeper := auth.NewFeeCollectionKeeper(cdc, keyFeeCollection)
+	invariant := SupplyInvariant(keeper, accountKeeper, feeCollectionKeeper)
+
+	//Nothing has been bridged
+	require.NoError(t, invariant(ctx))
+
+	//Coins minted by the bridge, part of which were paid as fees
+	receiverAddress, sdkErr := sdk.AccAddressFromBech32(types.TestAddress)
+	require.NoError(t, sdkErr)
+	minted := sdk.Coins{sdk.NewInt64Coin("ethereum", 10)}
+	_, _, mintErr := bankKeeper.AddCoins(ctx, receiverAddress, minted)
+	require.Nil(t, mintErr)
+	keeper.AddBridgedSupply(ctx, minted)
+	require.NoError(t, invariant(ctx))
+
+	fees := sdk.Coins{sdk.NewInt64Coin("ethereum", 2)}
+	_, _, feeErr := bankKeeper.SubtractCoins(ctx, receiverAddress, fees)
+	require.Nil(t, feeErr)
+	feeCollectionKeeper.AddCollectedFees(ctx, fees)
+	require.NoError(t, invariant(ctx))
+
+	//Coins of a bridged denom which the bridge did not mint break the invariant
+	_, _, mintErr = bankKeeper.AddCoins(ctx, receiverAddress, sdk.Coins{sdk.NewInt64Coin("ethereum", 1)})
+	require.Nil(t, mintErr)
+	require.Error(t, invariant(ctx))
+}
+
+func TestRelayerRewardsInvariant(t *testing.T) {
+	keyEthBridge := sdk.NewKVStoreKey(types.StoreKey)
+	ctx, accountKeeper, oracleKeeper, bankKeeper, paramsKeeper, validatorAddresses, err := oracleKeeperLib.CreateTestKeepersWithParams(t, 0.7, []int64{3, 7}, keyEthBridge)
+	require.Nil(t, err)
+	keeper := NewKeeper(keyEthBridge, oracleKeeperLib.MakeTestCodec(), paramsKeeper.Subspace(types.DefaultParamspace), types.DefaultCodespace)
+	invariant := RelayerRewardsInvariant(keeper, accountKeeper)
+
+	//Nothing is owed to relayers
+	require.NoError(t, invariant(ctx))
+
+	//A fee kept in the module account is owed to the validators it is split between
+	fee := sdk.Coins{sdk.NewInt64Coin("ethereum", 11)}
+	require.Nil(t, keeper.MintCoins(ctx, bankKeeper, fee))
+	keeper.AllocateRelayerRewards(ctx, oracleKeeper, fee, validatorAddresses)
+	require.NoError(t, invariant(ctx))
+	require.Equal(t, "3ethereum", keeper.GetRelayerRewards(ctx, sdk.AccAddress(validatorAddresses[0])).String())
+	require.Equal(t, "7ethereum", keeper.GetRelayerRewards(ctx, sdk.AccAddress(validatorAddresses[1])).String())
+	require.Equal(t, "1ethereum", keeper.GetUndistributedFees(ctx).String())
+
+	//Withdrawn rewards leave the module account
+	_, _, withdrawErr := keeper.WithdrawRelayerRewards(ctx, bankKeeper, sdk.AccAddress(validatorAddresses[1]))
+	require.Nil(t, withdrawErr)
+	require.NoError(t, invariant(ctx))
+
+	//Coins sent to the module account which are not owed do not break the invariant and are not paid to relayers
+	_, _, mintErr := bankKeeper.AddCoins(ctx, types.ModuleAddress, sdk.Coins{sdk.NewInt64Coin("ethereum", 2), sdk.NewInt64Coin("stake", 1)})
+	require.Nil(t, mintErr)
+	require.NoError(t, invariant(ctx))
+	require.Equal(t, "1ethereum", keeper.GetUndistributedFees(ctx).String())
+	require.Equal(t, "4ethereum", keeper.GetOwedRelayerFees(ctx).String())
+
+	//The module account holding less than it owes breaks the invariant
+	_, _, burnErr := bankKeeper.SubtractCoins(ctx, types.ModuleAddress, sdk.Coins{sdk.NewInt64Coin("ethereum", 3)})
+	require.Nil(t, burnErr)
+	require.Error(t, invariant(ctx))
+}
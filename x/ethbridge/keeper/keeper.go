@@ -0,0 +1,356 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/swishlabsco/cosmos-ethereum-bridge/x/ethbridge/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Keeper maintains the link to data storage and exposes getter/setter methods for the ethbridge's own state
+type Keeper struct {
+	storeKey sdk.StoreKey // Unexposed key to access store from sdk.Context
+
+	cdc *codec.Codec // The wire codec for binary encoding/decoding.
+
+	paramSpace params.Subspace
+
+	codespace sdk.CodespaceType
+}
+
+// NewKeeper creates new instances of the ethbridge Keeper
+func NewKeeper(storeKey sdk.StoreKey, cdc *codec.Codec, paramSpace params.Subspace, codespace sdk.CodespaceType) Keeper {
+	return Keeper{
+		storeKey:   storeKey,
+		cdc:        cdc,
+		paramSpace: paramSpace.WithKeyTable(types.ParamKeyTable()),
+		codespace:  codespace,
+	}
+}
+
+// Codespace returns the codespace
+func (k Keeper) Codespace() sdk.CodespaceType {
+	return k.codespace
+}
+
+// GetParams returns the ethbridge params. Params which were never set, as on chains started before they were
+// added, keep their default values.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	params := types.DefaultParams()
+	k.paramSpace.GetIfExists(ctx, types.KeySequentialMinting, &params.SequentialMinting)
+	k.paramSpace.GetIfExists(ctx, types.KeyAdmin, &params.Admin)
+	k.paramSpace.GetIfExists(ctx, types.KeyBridgeFeeFlat, &params.BridgeFeeFlat)
+	k.paramSpace.GetIfExists(ctx, types.KeyBridgeFeeBasisPoints, &params.BridgeFeeBasisPoints)
+	k.paramSpace.GetIfExists(ctx, types.KeyMintLimitWindow, &params.MintLimitWindow)
+	k.paramSpace.GetIfExists(ctx, types.KeyGlobalMintLimit, &params.GlobalMintLimit)
+	k.paramSpace.GetIfExists(ctx, types.KeyReceiverMintLimit, &params.ReceiverMintLimit)
+	k.paramSpace.GetIfExists(ctx, types.KeyEscrowThreshold, &params.EscrowThreshold)
+	k.paramSpace.GetIfExists(ctx, types.KeyEscrowBlocks, &params.EscrowBlocks)
+	return params
+}
+
+// SetParams sets the ethbridge params
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// GetBridgeStatus returns the last status of the bridge contract on an ethereum chain attested by validators,
+// or the status of a newly deployed contract if none has been attested yet
+func (k Keeper) GetBridgeStatus(ctx sdk.Context, ethereumChainID int) types.BridgeStatus {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetBridgeStatusKey(ethereumChainID)
+	if !store.Has(key) {
+		return types.DefaultBridgeStatus(ethereumChainID)
+	}
+	var status types.BridgeStatus
+	k.cdc.MustUnmarshalBinaryBare(store.Get(key), &status)
+	return status
+}
+
+// SetBridgeStatus stores an attested status of a chain's bridge contract, unless a status from a later
+// ethereum block has already been stored for that chain. It returns true if the status was stored.
+func (k Keeper) SetBridgeStatus(ctx sdk.Context, status types.BridgeStatus) bool {
+	if status.EthereumBlock < k.GetBridgeStatus(ctx, status.EthereumChainID).EthereumBlock {
+		return false
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetBridgeStatusKey(status.EthereumChainID), k.cdc.MustMarshalBinaryBare(status))
+	return true
+}
+
+// GetProphecyIDVersion returns the version of the format of the prophecy ids stored in the oracle, which is 0 if
+// they have never been migrated
+func (k Keeper) GetProphecyIDVersion(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has(types.ProphecyIDVersionKey) {
+		return 0
+	}
+	var version uint64
+	k.cdc.MustUnmarshalBinaryBare(store.Get(types.ProphecyIDVersionKey), &version)
+	return version
+}
+
+// SetProphecyIDVersion records the version of the format of the prophecy ids stored in the oracle
+func (k Keeper) SetProphecyIDVersion(ctx sdk.Context, version uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ProphecyIDVersionKey, k.cdc.MustMarshalBinaryBare(version))
+}
+
+// SetNonceProphecy indexes a lock prophecy by a nonce claimed for its lock
+func (k Keeper) SetNonceProphecy(ctx sdk.Context, nonceProphecy types.NonceProphecy) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(nonceProphecy.Key(), k.cdc.MustMarshalBinaryBare(nonceProphecy))
+}
+
+// GetNonceProphecies returns the prophecies indexed by a nonce of a bridge contract
+func (k Keeper) GetNonceProphecies(ctx sdk.Context, ethereumChainID int, bridgeContractAddress string, nonce sdk.Uint) []types.NonceProphecy {
+	var nonceProphecies []types.NonceProphecy
+	prefix := types.NonceProphecyNoncePrefix(ethereumChainID, bridgeContractAddress, nonce)
+	k.iterateNonceProphecies(ctx, prefix, func(nonceProphecy types.NonceProphecy) bool {
+		nonceProphecies = append(nonceProphecies, nonceProphecy)
+		return false
+	})
+	return nonceProphecies
+}
+
+// IterateNonceProphecies calls cb on the index entries of a bridge contract in order of their nonces, until cb
+// returns true
+func (k Keeper) IterateNonceProphecies(ctx sdk.Context, ethereumChainID int, bridgeContractAddress string, cb func(nonceProphecy types.NonceProphecy) (stop bool)) {
+	k.iterateNonceProphecies(ctx, types.NonceProphecyContractPrefix(ethereumChainID, bridgeContractAddress), cb)
+}
+
+func (k Keeper) iterateNonceProphecies(ctx sdk.Context, prefix []byte, cb func(nonceProphecy types.NonceProphecy) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var nonceProphecy types.NonceProphecy
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &nonceProphecy)
+		if cb(nonceProphecy) {
+			return
+		}
+	}
+}
+
+// GetLastFinalizedNonce returns the highest nonce of a bridge contract up to which the prophecies on every nonce
+// have been finalized. Bridge contracts number their locks from 1, so it is 0 until the first lock is finalized.
+func (k Keeper) GetLastFinalizedNonce(ctx sdk.Context, ethereumChainID int, bridgeContractAddress string) sdk.Uint {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetLastFinalizedNonceKey(ethereumChainID, bridgeContractAddress)
+	if !store.Has(key) {
+		return sdk.ZeroUint()
+	}
+	var nonce sdk.Uint
+	k.cdc.MustUnmarshalBinaryBare(store.Get(key), &nonce)
+	return nonce
+}
+
+// SetLastFinalizedNonce records the last finalized nonce of a bridge contract
+func (k Keeper) SetLastFinalizedNonce(ctx sdk.Context, ethereumChainID int, bridgeContractAddress string, nonce sdk.Uint) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetLastFinalizedNonceKey(ethereumChainID, bridgeContractAddress), k.cdc.MustMarshalBinaryBare(nonce))
+}
+
+// IterateLastFinalizedNonces calls cb on the last finalized nonce of each bridge contract, until cb returns true
+func (k Keeper) IterateLastFinalizedNonces(ctx sdk.Context, cb func(lastFinalizedNonce types.LastFinalizedNonce) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.LastFinalizedNonceKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		ethereumChainID, bridgeContractAddress, ok := types.ContractFromLastFinalizedNonceKey(iterator.Key())
+		if !ok {
+			continue
+		}
+		var nonce sdk.Uint
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &nonce)
+		if cb(types.NewLastFinalizedNonce(ethereumChainID, bridgeContractAddress, nonce)) {
+			return
+		}
+	}
+}
+
+// SetQueuedMint queues the mint of a successful prophecy until the prophecies on the earlier nonces of its bridge
+// contract are finalized
+func (k Keeper) SetQueuedMint(ctx sdk.Context, nonceProphecy types.NonceProphecy) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetQueuedMintKey(nonceProphecy.ProphecyID.EthereumChainID, nonceProphecy.ProphecyID.BridgeContractAddress, nonceProphecy.Nonce)
+	store.Set(key, k.cdc.MustMarshalBinaryBare(nonceProphecy))
+}
+
+// GetQueuedMint returns the mint queued on a nonce of a bridge contract, or false if there is none
+func (k Keeper) GetQueuedMint(ctx sdk.Context, ethereumChainID int, bridgeContractAddress string, nonce sdk.Uint) (types.NonceProphecy, bool) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetQueuedMintKey(ethereumChainID, bridgeContractAddress, nonce)
+	if !store.Has(key) {
+		return types.NonceProphecy{}, false
+	}
+	var nonceProphecy types.NonceProphecy
+	k.cdc.MustUnmarshalBinaryBare(store.Get(key), &nonceProphecy)
+	return nonceProphecy, true
+}
+
+// DeleteQueuedMint removes the mint queued on a nonce of a bridge contract
+func (k Keeper) DeleteQueuedMint(ctx sdk.Context, ethereumChainID int, bridgeContractAddress string, nonce sdk.Uint) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetQueuedMintKey(ethereumChainID, bridgeContractAddress, nonce))
+}
+
+// IterateQueuedMints calls cb on the queued mints of a bridge contract in order of their nonces, until cb returns
+// true
+func (k Keeper) IterateQueuedMints(ctx sdk.Context, ethereumChainID int, bridgeContractAddress string, cb func(nonceProphecy types.NonceProphecy) (stop bool)) {
+	k.iterateQueuedMints(ctx, types.QueuedMintContractPrefix(ethereumChainID, bridgeContractAddress), cb)
+}
+
+// IterateAllQueuedMints calls cb on the queued mints of every bridge contract, in order of their nonces within each
+// contract, until cb returns true
+func (k Keeper) IterateAllQueuedMints(ctx sdk.Context, cb func(nonceProphecy types.NonceProphecy) (stop bool)) {
+	k.iterateQueuedMints(ctx, types.QueuedMintKeyPrefix, cb)
+}
+
+func (k Keeper) iterateQueuedMints(ctx sdk.Context, prefix []byte, cb func(nonceProphecy types.NonceProphecy) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var nonceProphecy types.NonceProphecy
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &nonceProphecy)
+		if cb(nonceProphecy) {
+			return
+		}
+	}
+}
+
+// IsMintingPaused returns true if the bridge admin has paused minting
+func (k Keeper) IsMintingPaused(ctx sdk.Context) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.MintingPausedKey)
+}
+
+// SetMintingPaused pauses or resumes minting
+func (k Keeper) SetMintingPaused(ctx sdk.Context, paused bool) {
+	store := ctx.KVStore(k.storeKey)
+	if paused {
+		store.Set(types.MintingPausedKey, []byte{1})
+	} else {
+		store.Delete(types.MintingPausedKey)
+	}
+}
+
+// SetPausedMint holds the mint of a successful prophecy until minting is resumed
+func (k Keeper) SetPausedMint(ctx sdk.Context, nonceProphecy types.NonceProphecy) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetPausedMintKey(nonceProphecy.ProphecyID.EthereumChainID, nonceProphecy.ProphecyID.BridgeContractAddress, nonceProphecy.Nonce)
+	store.Set(key, k.cdc.MustMarshalBinaryBare(nonceProphecy))
+}
+
+// GetPausedMint returns the mint held on a nonce of a bridge contract, or false if there is none
+func (k Keeper) GetPausedMint(ctx sdk.Context, ethereumChainID int, bridgeContractAddress string, nonce sdk.Uint) (types.NonceProphecy, bool) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetPausedMintKey(ethereumChainID, bridgeContractAddress, nonce)
+	if !store.Has(key) {
+		return types.NonceProphecy{}, false
+	}
+	var nonceProphecy types.NonceProphecy
+	k.cdc.MustUnmarshalBinaryBare(store.Get(key), &nonceProphecy)
+	return nonceProphecy, true
+}
+
+// DeletePausedMint removes the mint held on a nonce of a bridge contract
+func (k Keeper) DeletePausedMint(ctx sdk.Context, ethereumChainID int, bridgeContractAddress string, nonce sdk.Uint) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetPausedMintKey(ethereumChainID, bridgeContractAddress, nonce))
+}
+
+// IteratePausedMints calls cb on the paused mints of every bridge contract, in order of their nonces within each
+// contract, until cb returns true
+func (k Keeper) IteratePausedMints(ctx sdk.Context, cb func(nonceProphecy types.NonceProphecy) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.PausedMintKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var nonceProphecy types.NonceProphecy
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &nonceProphecy)
+		if cb(nonceProphecy) {
+			return
+		}
+	}
+}
+
+// GetBridgedSupplyOf returns the amount of a denom minted by the bridge which has not been clawed back
+func (k Keeper) GetBridgedSupplyOf(ctx sdk.Context, denom string) sdk.Int {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetBridgedSupplyKey(denom)
+	if !store.Has(key) {
+		return sdk.ZeroInt()
+	}
+	var amount sdk.Int
+	k.cdc.MustUnmarshalBinaryBare(store.Get(key), &amount)
+	return amount
+}
+
+// GetBridgedSupply returns the coins minted by the bridge which have not been clawed back
+func (k Keeper) GetBridgedSupply(ctx sdk.Context) sdk.Coins {
+	supply := sdk.Coins{}
+	k.IterateBridgedSupply(ctx, func(denom string, amount sdk.Int) bool {
+		if !amount.IsZero() {
+			supply = append(supply, sdk.NewCoin(denom, amount))
+		}
+		return false
+	})
+	return supply
+}
+
+// SetBridgedSupply sets the bridged supply of the denoms of the given coins
+func (k Keeper) SetBridgedSupply(ctx sdk.Context, coins sdk.Coins) {
+	for _, coin := range coins {
+		k.setBridgedSupplyOf(ctx, coin.Denom, coin.Amount)
+	}
+}
+
+// AddBridgedSupply records coins minted by the bridge
+func (k Keeper) AddBridgedSupply(ctx sdk.Context, coins sdk.Coins) {
+	for _, coin := range coins {
+		k.setBridgedSupplyOf(ctx, coin.Denom, k.GetBridgedSupplyOf(ctx, coin.Denom).Add(coin.Amount))
+	}
+}
+
+// SubtractBridgedSupply records coins minted by the bridge which were clawed back
+func (k Keeper) SubtractBridgedSupply(ctx sdk.Context, coins sdk.Coins) {
+	for _, coin := range coins {
+		k.setBridgedSupplyOf(ctx, coin.Denom, k.GetBridgedSupplyOf(ctx, coin.Denom).Sub(coin.Amount))
+	}
+}
+
+// IterateBridgedSupply calls cb on the bridged supply of each denom the bridge has minted, in order of the denoms,
+// until cb returns true. Denoms whose coins were all clawed back are included with a zero amount.
+func (k Keeper) IterateBridgedSupply(ctx sdk.Context, cb func(denom string, amount sdk.Int) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.BridgedSupplyKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var amount sdk.Int
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &amount)
+		if cb(string(iterator.Key()[len(types.BridgedSupplyKeyPrefix):]), amount) {
+			return
+		}
+	}
+}
+
+// IsSupplyTracked returns true if the bridged supply accounts for every coin the bridge has minted, which is not
+// the case on a chain which minted coins before it tracked the bridged supply, until the supply is back-filled
+func (k Keeper) IsSupplyTracked(ctx sdk.Context) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.SupplyTrackedKey)
+}
+
+// SetSupplyTracked records that the bridged supply accounts for every coin the bridge has minted
+func (k Keeper) SetSupplyTracked(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.SupplyTrackedKey, []byte{1})
+}
+
+func (k Keeper) setBridgedSupplyOf(ctx sdk.Context, denom string, amount sdk.Int) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetBridgedSupplyKey(denom), k.cdc.MustMarshalBinaryBare(amount))
+}
@@ -0,0 +1,262 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/swishlabsco/cosmos-ethereum-bridge/x/ethbridge/types"
+)
+
+func TestBridgeStatus(t *testing.T) {
+	ctx, keeper, _, _, _ := CreateTestKeepers(t, 0.7, []int64{3, 7})
+	chainID := types.TestEthereumChainID
+
+	//A newly deployed contract is active
+	require.Equal(t, types.DefaultBridgeStatus(chainID), keeper.GetBridgeStatus(ctx, chainID))
+
+	//Pausing is stored
+	paused := types.NewBridgeStatus(chainID, false, 100)
+	require.True(t, keeper.SetBridgeStatus(ctx, paused))
+	require.Equal(t, paused, keeper.GetBridgeStatus(ctx, chainID))
+
+	//A status attested from an earlier block does not overwrite a later one
+	require.False(t, keeper.SetBridgeStatus(ctx, types.NewBridgeStatus(chainID, true, 90)))
+	require.Equal(t, paused, keeper.GetBridgeStatus(ctx, chainID))
+
+	activated := types.NewBridgeStatus(chainID, true, 120)
+	require.True(t, keeper.SetBridgeStatus(ctx, activated))
+	require.Equal(t, activated, keeper.GetBridgeStatus(ctx, chainID))
+
+	//Each chain's bridge contract has its own status, regardless of block numbers on other chains
+	otherChainID := chainID + 1
+	require.Equal(t, types.DefaultBridgeStatus(otherChainID), keeper.GetBridgeStatus(ctx, otherChainID))
+	otherPaused := types.NewBridgeStatus(otherChainID, false, 10)
+	require.True(t, keeper.SetBridgeStatus(ctx, otherPaused))
+	require.Equal(t, otherPaused, keeper.GetBridgeStatus(ctx, otherChainID))
+	require.Equal(t, activated, keeper.GetBridgeStatus(ctx, chainID))
+}
+
+func TestNonceProphecies(t *testing.T) {
+	ctx, keeper, _, _, _ := CreateTestKeepers(t, 0.7, []int64{3, 7})
+	chainID := types.TestEthereumChainID
+	contract := types.TestBridgeContract
+	largeNonce, err := types.ParseNonce(types.TestLargeNonce)
+	require.NoError(t, err)
+
+	prophecyID := types.NewProphecyID(chainID, contract, types.TestEthereumItemID)
+	otherProphecyID := types.NewProphecyID(chainID, contract, types.AltTestEthereumItemID)
+	entries := []types.NonceProphecy{
+		types.NewNonceProphecy(sdk.NewUint(1), prophecyID, string(prophecyID.Key())),
+		types.NewNonceProphecy(sdk.NewUint(256), prophecyID, string(prophecyID.Key())),
+		types.NewNonceProphecy(largeNonce, otherProphecyID, string(otherProphecyID.Key())),
+		types.NewNonceProphecy(sdk.NewUint(1), otherProphecyID, string(otherProphecyID.Key())),
+	}
+	for _, entry := range entries {
+		keeper.SetNonceProphecy(ctx, entry)
+	}
+
+	//Entries on another chain or contract are kept apart
+	otherChainID := types.NewProphecyID(chainID+1, contract, types.TestEthereumItemID)
+	keeper.SetNonceProphecy(ctx, types.NewNonceProphecy(sdk.NewUint(1), otherChainID, string(otherChainID.Key())))
+	otherContractID := types.NewProphecyID(chainID, types.TestEthereumAddress, types.TestEthereumItemID)
+	keeper.SetNonceProphecy(ctx, types.NewNonceProphecy(sdk.NewUint(1), otherContractID, string(otherContractID.Key())))
+
+	//Each nonce has the prophecies claiming it
+	nonceProphecies := keeper.GetNonceProphecies(ctx, chainID, contract, sdk.NewUint(1))
+	require.Len(t, nonceProphecies, 2)
+	require.Equal(t, otherProphecyID, nonceProphecies[0].ProphecyID)
+	require.Equal(t, prophecyID, nonceProphecies[1].ProphecyID)
+	require.Empty(t, keeper.GetNonceProphecies(ctx, chainID, contract, sdk.NewUint(0)))
+	require.Len(t, keeper.GetNonceProphecies(ctx, chainID, contract, largeNonce), 1)
+
+	//Entries are iterated in numeric order of their nonces
+	var nonces []string
+	keeper.IterateNonceProphecies(ctx, chainID, contract, func(nonceProphecy types.NonceProphecy) bool {
+		nonces = append(nonces, nonceProphecy.Nonce.String())
+		return false
+	})
+	require.Equal(t, []string{"1", "1", "256", types.TestLargeNonce}, nonces)
+}
+
+func TestParams(t *testing.T) {
+	ctx, keeper, _, _, validatorAddresses := CreateTestKeepers(t, 0.7, []int64{3, 7})
+
+	//Params which were never set have their defaults
+	require.Equal(t, types.DefaultParams(), keeper.GetParams(ctx))
+
+	mintLimits := types.NewMintLimits(10, sdk.Coins{sdk.NewInt64Coin("ethereum", 100)}, sdk.Coins{sdk.NewInt64Coin("ethereum", 20)})
+	params := types.NewParams(true, sdk.AccAddress(validatorAddresses[0]), sdk.Coins{sdk.NewInt64Coin("ethereum", 1)}, 25, mintLimits,
+		sdk.Coins{sdk.NewInt64Coin("ethereum", 50)}, 5)
+	keeper.SetParams(ctx, params)
+	require.Equal(t, params, keeper.GetParams(ctx))
+}
+
+func TestQueuedMints(t *testing.T) {
+	ctx, keeper, _, _, _ := CreateTestKeepers(t, 0.7, []int64{3, 7})
+	chainID := types.TestEthereumChainID
+	contract := types.TestBridgeContract
+
+	//No nonce is finalized on a new contract
+	require.True(t, keeper.GetLastFinalizedNonce(ctx, chainID, contract).IsZero())
+	keeper.SetLastFinalizedNonce(ctx, chainID, contract, sdk.NewUint(2))
+	require.Equal(t, sdk.NewUint(2), keeper.GetLastFinalizedNonce(ctx, chainID, contract))
+	require.True(t, keeper.GetLastFinalizedNonce(ctx, chainID+1, contract).IsZero())
+
+	prophecyID := types.NewProphecyID(chainID, contract, types.TestEthereumItemID)
+	otherProphecyID := types.NewProphecyID(chainID, contract, types.AltTestEthereumItemID)
+	queuedMint := types.NewNonceProphecy(sdk.NewUint(256), prophecyID, string(prophecyID.Key()))
+	otherQueuedMint := types.NewNonceProphecy(sdk.NewUint(4), otherProphecyID, string(otherProphecyID.Key()))
+	keeper.SetQueuedMint(ctx, queuedMint)
+	keeper.SetQueuedMint(ctx, otherQueuedMint)
+
+	stored, ok := keeper.GetQueuedMint(ctx, chainID, contract, sdk.NewUint(256))
+	require.True(t, ok)
+	require.Equal(t, queuedMint, stored)
+	_, ok = keeper.GetQueuedMint(ctx, chainID, contract, sdk.NewUint(3))
+	require.False(t, ok)
+
+	//Queued mints are iterated in numeric order of their nonces
+	var nonces []string
+	keeper.IterateQueuedMints(ctx, chainID, contract, func(nonceProphecy types.NonceProphecy) bool {
+		nonces = append(nonces, nonceProphecy.Nonce.String())
+		return false
+	})
+	require.Equal(t, []string{"4", "256"}, nonces)
+
+	keeper.DeleteQueuedMint(ctx, chainID, contract, sdk.NewUint(4))
+	_, ok = keeper.GetQueuedMint(ctx, chainID, contract, sdk.NewUint(4))
+	require.False(t, ok)
+}
+
+func TestBridgedSupply(t *testing.T) {
+	ctx, keeper, _, _, _ := CreateTestKeepers(t, 0.7, []int64{3, 7})
+	require.True(t, keeper.GetBridgedSupply(ctx).IsZero())
+
+	minted, err := sdk.ParseCoins("10ethereum,5token")
+	require.NoError(t, err)
+	keeper.AddBridgedSupply(ctx, minted)
+	keeper.AddBridgedSupply(ctx, sdk.Coins{sdk.NewInt64Coin("ethereum", 2)})
+	expected, err := sdk.ParseCoins("12ethereum,5token")
+	require.NoError(t, err)
+	require.True(t, keeper.GetBridgedSupply(ctx).IsEqual(expected))
+	require.Equal(t, sdk.NewInt(12), keeper.GetBridgedSupplyOf(ctx, "ethereum"))
+
+	//A denom whose coins were all clawed back is left out of the supply, but is still iterated
+	keeper.SubtractBridgedSupply(ctx, sdk.Coins{sdk.NewInt64Coin("token", 5)})
+	require.True(t, keeper.GetBridgedSupply(ctx).IsEqual(sdk.Coins{sdk.NewInt64Coin("ethereum", 12)}))
+	var denoms []string
+	keeper.IterateBridgedSupply(ctx, func(denom string, amount sdk.Int) bool {
+		denoms = append(denoms, denom)
+		return false
+	})
+	require.Equal(t, []string{"ethereum", "token"}, denoms)
+}
+
+func TestPausedMints(t *testing.T) {
+	ctx, keeper, _, _, _ := CreateTestKeepers(t, 0.7, []int64{3, 7})
+	chainID := types.TestEthereumChainID
+	contract := types.TestBridgeContract
+
+	//Minting is active on a new chain
+	require.False(t, keeper.IsMintingPaused(ctx))
+	keeper.SetMintingPaused(ctx, true)
+	require.True(t, keeper.IsMintingPaused(ctx))
+	keeper.SetMintingPaused(ctx, false)
+	require.False(t, keeper.IsMintingPaused(ctx))
+
+	//Paused mints are iterated in order of their nonces
+	for _, nonce := range []uint64{256, 1, 2} {
+		id := types.NewProphecyID(chainID, contract, types.TestEthereumItemID)
+		keeper.SetPausedMint(ctx, types.NewNonceProphecy(sdk.NewUint(nonce), id, string(id.Key())))
+	}
+	var nonces []string
+	keeper.IteratePausedMints(ctx, func(pausedMint types.NonceProphecy) bool {
+		nonces = append(nonces, pausedMint.Nonce.String())
+		return false
+	})
+	require.Equal(t, []string{"1", "2", "256"}, nonces)
+
+	pausedMint, ok := keeper.GetPausedMint(ctx, chainID, contract, sdk.NewUint(2))
+	require.True(t, ok)
+	require.Equal(t, sdk.NewUint(2), pausedMint.Nonce)
+	keeper.DeletePausedMint(ctx, chainID, contract, sdk.NewUint(2))
+	_, ok = keeper.GetPausedMint(ctx, chainID, contract, sdk.NewUint(2))
+	require.False(t, ok)
+}
+
+func TestMintAndBurnCoins(t *testing.T) {
+	ctx, keeper, _, bankKeeper, _ := CreateTestKeepers(t, 0.7, []int64{3, 7})
+	receiver, err := sdk.AccAddressFromBech32(types.TestAddress)
+	require.NoError(t, err)
+	coins := sdk.Coins{sdk.NewInt64Coin("ethereum", 10)}
+
+	//Minting adds the coins to the module account, the minted coins and the bridged supply
+	require.NoError(t, keeper.MintCoins(ctx, bankKeeper, coins))
+	require.True(t, bankKeeper.GetCoins(ctx, types.ModuleAddress).IsEqual(coins))
+	require.True(t, keeper.GetMinted(ctx).IsEqual(coins))
+	require.True(t, keeper.GetBridgedSupply(ctx).IsEqual(coins))
+
+	_, err = keeper.SendCoinsFromModuleToAccount(ctx, bankKeeper, receiver, coins)
+	require.NoError(t, err)
+	require.True(t, bankKeeper.GetCoins(ctx, types.ModuleAddress).IsZero())
+	require.True(t, bankKeeper.GetCoins(ctx, receiver).IsEqual(coins))
+
+	//Only coins held by the module account can be burned
+	clawback := sdk.Coins{sdk.NewInt64Coin("ethereum", 4)}
+	require.Error(t, keeper.BurnCoins(ctx, bankKeeper, clawback))
+	_, err = keeper.SendCoinsFromAccountToModule(ctx, bankKeeper, receiver, clawback)
+	require.NoError(t, err)
+	require.NoError(t, keeper.BurnCoins(ctx, bankKeeper, clawback))
+	require.True(t, keeper.GetBurned(ctx).IsEqual(clawback))
+	require.Equal(t, "6ethereum", keeper.GetBridgedSupply(ctx).String())
+	require.True(t, keeper.GetMinted(ctx).IsEqual(coins))
+}
+
+func TestMintLimits(t *testing.T) {
+	ctx, keeper, _, _, validatorAddresses := CreateTestKeepers(t, 0.7, []int64{3, 7})
+	receiver := sdk.AccAddress(validatorAddresses[0])
+	otherReceiver := sdk.AccAddress(validatorAddresses[1])
+	coins := func(amount int64) sdk.Coins {
+		return sdk.Coins{sdk.NewInt64Coin("ethereum", amount)}
+	}
+
+	//Mints are not limited or recorded by default
+	require.True(t, keeper.IsWithinMintLimits(ctx, receiver, coins(1000000)))
+	keeper.RecordWindowMint(ctx, receiver, coins(1000000))
+	minted, _ := keeper.GetWindowMinted(ctx, receiver)
+	require.True(t, minted.IsZero())
+
+	mintLimits := types.NewMintLimits(3, coins(30), coins(20))
+	keeper.SetParams(ctx, types.NewParams(false, nil, sdk.Coins{}, 0, mintLimits, nil, 0))
+
+	//Mints count against the global limit and the limit of their receiver
+	ctx = ctx.WithBlockHeight(1)
+	require.True(t, keeper.IsWithinMintLimits(ctx, receiver, coins(20)))
+	require.False(t, keeper.IsWithinMintLimits(ctx, receiver, coins(21)))
+	keeper.RecordWindowMint(ctx, receiver, coins(15))
+	require.False(t, keeper.IsWithinMintLimits(ctx, receiver, coins(6)))
+	require.True(t, keeper.IsWithinMintLimits(ctx, otherReceiver, coins(15)))
+	require.False(t, keeper.IsWithinMintLimits(ctx, otherReceiver, coins(16)))
+
+	//Denoms without a limit are not limited
+	require.True(t, keeper.IsWithinMintLimits(ctx, receiver, sdk.Coins{sdk.NewInt64Coin("stake", 1000)}))
+
+	//Mints leave the window after it has passed
+	ctx = ctx.WithBlockHeight(3)
+	minted, mintedToReceiver := keeper.GetWindowMinted(ctx, receiver)
+	require.Equal(t, "15ethereum", minted.String())
+	require.Equal(t, "15ethereum", mintedToReceiver.String())
+	_, mintedToReceiver = keeper.GetWindowMinted(ctx, otherReceiver)
+	require.True(t, mintedToReceiver.IsZero())
+	ctx = ctx.WithBlockHeight(4)
+	minted, _ = keeper.GetWindowMinted(ctx, receiver)
+	require.True(t, minted.IsZero())
+	require.True(t, keeper.IsWithinMintLimits(ctx, receiver, coins(20)))
+
+	//Pruning forgets them
+	keeper.PruneWindowMints(ctx)
+	minted, _ = keeper.GetWindowMinted(ctx.WithBlockHeight(3), receiver)
+	require.True(t, minted.IsZero())
+}
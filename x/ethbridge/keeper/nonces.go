@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/swishlabsco/cosmos-ethereum-bridge/x/ethbridge/types"
+	"github.com/swishlabsco/cosmos-ethereum-bridge/x/oracle"
+)
+
+// IsNonceFinalized returns true if a prophecy has minted the nonce of a bridge contract, or if the nonce has
+// prophecies and none of them is still pending
+func (k Keeper) IsNonceFinalized(ctx sdk.Context, oracleKeeper oracle.Keeper, ethereumChainID int, bridgeContractAddress string, nonce sdk.Uint) bool {
+	return isNonceFinalized(ctx, oracleKeeper, nonce, k.GetNonceProphecies(ctx, ethereumChainID, bridgeContractAddress, nonce))
+}
+
+func isNonceFinalized(ctx sdk.Context, oracleKeeper oracle.Keeper, nonce sdk.Uint, nonceProphecies []types.NonceProphecy) bool {
+	pending := len(nonceProphecies) == 0
+	for _, nonceProphecy := range nonceProphecies {
+		prophecy, err := oracleKeeper.GetProphecy(ctx, nonceProphecy.OracleID)
+		if err != nil || prophecy.Status.StatusText == oracle.PendingStatus {
+			pending = true
+			continue
+		}
+		if prophecy.Status.StatusText != oracle.SuccessStatus {
+			continue
+		}
+		finalClaim, err := types.CreateOracleClaimFromOracleString(prophecy.Status.FinalClaim)
+		if err == nil && finalClaim.Nonce.Equal(nonce) {
+			return true
+		}
+	}
+	return !pending
+}
+
+// NonceGaps returns up to limit nonces of a bridge contract after its last finalized nonce which have not been
+// finalized, either because no lock was claimed on them or because their prophecies are still pending, together
+// with the highest nonce claimed on the contract
+func (k Keeper) NonceGaps(ctx sdk.Context, oracleKeeper oracle.Keeper, ethereumChainID int, bridgeContractAddress string, limit int) ([]types.NonceGap, sdk.Uint) {
+	lastFinalized := k.GetLastFinalizedNonce(ctx, ethereumChainID, bridgeContractAddress)
+	highestClaimed := lastFinalized
+	expected := lastFinalized.Add(sdk.NewUint(1))
+	gaps := []types.NonceGap{}
+
+	var group []types.NonceProphecy
+	addGroup := func() {
+		nonce := group[0].Nonce
+		for ; expected.LT(nonce) && len(gaps) < limit; expected = expected.Add(sdk.NewUint(1)) {
+			gaps = append(gaps, types.NewNonceGap(expected, types.NonceGapMissing, nil))
+		}
+		if len(gaps) < limit && !isNonceFinalized(ctx, oracleKeeper, nonce, group) {
+			var prophecyIDs []types.ProphecyID
+			for _, nonceProphecy := range group {
+				prophecyIDs = append(prophecyIDs, nonceProphecy.ProphecyID)
+			}
+			gaps = append(gaps, types.NewNonceGap(nonce, types.NonceGapPending, prophecyIDs))
+		}
+		expected = nonce.Add(sdk.NewUint(1))
+		highestClaimed = nonce
+	}
+
+	k.IterateNonceProphecies(ctx, ethereumChainID, bridgeContractAddress, func(nonceProphecy types.NonceProphecy) bool {
+		if !nonceProphecy.Nonce.GT(lastFinalized) {
+			return false
+		}
+		if len(group) > 0 && !group[0].Nonce.Equal(nonceProphecy.Nonce) {
+			addGroup()
+			group = nil
+		}
+		group = append(group, nonceProphecy)
+		return false
+	})
+	if len(group) > 0 {
+		addGroup()
+	}
+	return gaps, highestClaimed
+}
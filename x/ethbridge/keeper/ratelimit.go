@@ -0,0 +1,154 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/swishlabsco/cosmos-ethereum-bridge/x/ethbridge/types"
+)
+
+// IsWithinMintLimits returns true if minting amount to receiver in the current block keeps the coins minted in the
+// window, in all and to the receiver, within the mint limits
+func (k Keeper) IsWithinMintLimits(ctx sdk.Context, receiver sdk.AccAddress, amount sdk.Coins) bool {
+	limits := k.GetParams(ctx).MintLimits()
+	if !limits.Enabled() {
+		return true
+	}
+	minted, mintedToReceiver := k.GetWindowMinted(ctx, receiver)
+	return !types.ExceedsMintLimit(minted, amount, limits.GlobalLimit) &&
+		!types.ExceedsMintLimit(mintedToReceiver, amount, limits.ReceiverLimit)
+}
+
+// CanReleaseDelayedMint returns true if a delayed mint of amount to receiver now fits in the mint limits. A mint
+// larger than a limit on its own never fits, so it is released once it has been delayed for a whole window, however
+// much has been minted in the window since.
+func (k Keeper) CanReleaseDelayedMint(ctx sdk.Context, delayedMint types.DelayedMint, receiver sdk.AccAddress, amount sdk.Coins) bool {
+	if k.IsWithinMintLimits(ctx, receiver, amount) {
+		return true
+	}
+	limits := k.GetParams(ctx).MintLimits()
+	oversized := types.ExceedsMintLimit(sdk.Coins{}, amount, limits.GlobalLimit) ||
+		types.ExceedsMintLimit(sdk.Coins{}, amount, limits.ReceiverLimit)
+	return oversized && ctx.BlockHeight()-delayedMint.DelayedAt >= limits.Window
+}
+
+// RecordWindowMint counts coins minted to a receiver in the current block against the mint limits
+func (k Keeper) RecordWindowMint(ctx sdk.Context, receiver sdk.AccAddress, amount sdk.Coins) {
+	if !k.GetParams(ctx).MintLimits().Enabled() {
+		return
+	}
+	key := types.GetWindowMintKey(ctx.BlockHeight(), receiver)
+	k.setCoins(ctx, key, k.getCoins(ctx, key).Add(amount))
+}
+
+// SetWindowMint sets the coins minted to a receiver at a block height
+func (k Keeper) SetWindowMint(ctx sdk.Context, windowMint types.WindowMint) {
+	k.setCoins(ctx, types.GetWindowMintKey(windowMint.Height, windowMint.Receiver), windowMint.Amount)
+}
+
+// IterateWindowMints calls cb on the coins minted to each receiver at each block height which has not been pruned,
+// in order of height, until cb returns true
+func (k Keeper) IterateWindowMints(ctx sdk.Context, cb func(windowMint types.WindowMint) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.WindowMintKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()[len(types.WindowMintKeyPrefix):]
+		var coins sdk.Coins
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &coins)
+		if cb(types.NewWindowMint(int64(binary.BigEndian.Uint64(key[:8])), sdk.AccAddress(append([]byte{}, key[8:]...)), coins)) {
+			return
+		}
+	}
+}
+
+// GetWindowMinted returns the coins minted in the current mint limit window, in all and to the given receiver
+func (k Keeper) GetWindowMinted(ctx sdk.Context, receiver sdk.AccAddress) (sdk.Coins, sdk.Coins) {
+	minted, mintedToReceiver := sdk.Coins{}, sdk.Coins{}
+	window := k.GetParams(ctx).MintLimitWindow
+	if window <= 0 {
+		return minted, mintedToReceiver
+	}
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.WindowMintPrefix(windowStart(ctx.BlockHeight(), window)), types.WindowMintPrefix(ctx.BlockHeight()+1))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var coins sdk.Coins
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &coins)
+		minted = minted.Add(coins)
+		if receiver.Equals(sdk.AccAddress(iterator.Key()[len(types.WindowMintPrefix(0)):])) {
+			mintedToReceiver = mintedToReceiver.Add(coins)
+		}
+	}
+	return minted, mintedToReceiver
+}
+
+// PruneWindowMints forgets the mints made before the current mint limit window, or all of them if mints are no
+// longer limited
+func (k Keeper) PruneWindowMints(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	end := sdk.PrefixEndBytes(types.WindowMintKeyPrefix)
+	if window := k.GetParams(ctx).MintLimitWindow; window > 0 {
+		end = types.WindowMintPrefix(windowStart(ctx.BlockHeight(), window))
+	}
+	iterator := store.Iterator(types.WindowMintKeyPrefix, end)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// windowStart returns the first block height of the window ending with the given height
+func windowStart(height int64, window int64) int64 {
+	start := height - window + 1
+	if start < 0 {
+		return 0
+	}
+	return start
+}
+
+// SetDelayedMint delays the mint of a successful prophecy until it fits in the mint limits
+func (k Keeper) SetDelayedMint(ctx sdk.Context, delayedMint types.DelayedMint) {
+	store := ctx.KVStore(k.storeKey)
+	id := delayedMint.NonceProphecy.ProphecyID
+	key := types.GetDelayedMintKey(id.EthereumChainID, id.BridgeContractAddress, delayedMint.NonceProphecy.Nonce)
+	store.Set(key, k.cdc.MustMarshalBinaryBare(delayedMint))
+}
+
+// GetDelayedMint returns the mint delayed on a nonce of a bridge contract, or false if there is none
+func (k Keeper) GetDelayedMint(ctx sdk.Context, ethereumChainID int, bridgeContractAddress string, nonce sdk.Uint) (types.DelayedMint, bool) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetDelayedMintKey(ethereumChainID, bridgeContractAddress, nonce)
+	if !store.Has(key) {
+		return types.DelayedMint{}, false
+	}
+	var delayedMint types.DelayedMint
+	k.cdc.MustUnmarshalBinaryBare(store.Get(key), &delayedMint)
+	return delayedMint, true
+}
+
+// DeleteDelayedMint removes the mint delayed on a nonce of a bridge contract
+func (k Keeper) DeleteDelayedMint(ctx sdk.Context, ethereumChainID int, bridgeContractAddress string, nonce sdk.Uint) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetDelayedMintKey(ethereumChainID, bridgeContractAddress, nonce))
+}
+
+// IterateDelayedMints calls cb on the delayed mints of every bridge contract, in order of their nonces within each
+// contract, until cb returns true
+func (k Keeper) IterateDelayedMints(ctx sdk.Context, cb func(delayedMint types.DelayedMint) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.DelayedMintKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var delayedMint types.DelayedMint
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &delayedMint)
+		if cb(delayedMint) {
+			return
+		}
+	}
+}
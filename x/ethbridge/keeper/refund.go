@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/swishlabsco/cosmos-ethereum-bridge/x/ethbridge/types"
+)
+
+// SetRefund stores the refund of a lock made to an invalid cosmos receiver
+func (k Keeper) SetRefund(ctx sdk.Context, refund types.Refund) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetRefundKey(refund.EthereumSender, refund.ProphecyID), k.cdc.MustMarshalBinaryBare(refund))
+}
+
+// GetRefund returns the refund of a lock prophecy made by an ethereum sender, or false if there is none
+func (k Keeper) GetRefund(ctx sdk.Context, ethereumSender string, prophecyID types.ProphecyID) (types.Refund, bool) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetRefundKey(ethereumSender, prophecyID)
+	if !store.Has(key) {
+		return types.Refund{}, false
+	}
+	var refund types.Refund
+	k.cdc.MustUnmarshalBinaryBare(store.Get(key), &refund)
+	return refund, true
+}
+
+// IterateRefunds calls cb on the refunds of every ethereum sender, in order of the senders, until cb returns true
+func (k Keeper) IterateRefunds(ctx sdk.Context, cb func(refund types.Refund) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.RefundKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var refund types.Refund
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &refund)
+		if cb(refund) {
+			return
+		}
+	}
+}
+
+// GetRefunds returns the refunds of the locks an ethereum sender made to invalid cosmos receivers
+func (k Keeper) GetRefunds(ctx sdk.Context, ethereumSender string) []types.Refund {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.RefundSenderPrefix(ethereumSender))
+	defer iterator.Close()
+	refunds := []types.Refund{}
+	for ; iterator.Valid(); iterator.Next() {
+		var refund types.Refund
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &refund)
+		refunds = append(refunds, refund)
+	}
+	return refunds
+}
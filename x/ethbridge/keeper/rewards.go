@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+
+	"github.com/swishlabsco/cosmos-ethereum-bridge/x/ethbridge/types"
+	"github.com/swishlabsco/cosmos-ethereum-bridge/x/oracle"
+)
+
+// AllocateRelayerRewards splits a bridge fee held by the ethbridge module account between the given validators in
+// proportion to their power, together with the fees left over by rounding earlier fees
+func (k Keeper) AllocateRelayerRewards(ctx sdk.Context, oracleKeeper oracle.Keeper, fee sdk.Coins, validators []sdk.ValAddress) {
+	powers := make([]int64, len(validators))
+	for i, validator := range validators {
+		powers[i] = oracleKeeper.GetValidatorPower(ctx, validator)
+	}
+	shares, remainder := types.SplitByPower(k.GetUndistributedFees(ctx).Add(fee), powers)
+	for i, validator := range validators {
+		if shares[i].IsZero() {
+			continue
+		}
+		address := sdk.AccAddress(validator)
+		k.setRelayerRewards(ctx, address, k.GetRelayerRewards(ctx, address).Add(shares[i]))
+	}
+	k.SetUndistributedFees(ctx, remainder)
+}
+
+// WithdrawRelayerRewards sends the bridge fees a validator has earned from the ethbridge module account to the
+// validator's account
+func (k Keeper) WithdrawRelayerRewards(ctx sdk.Context, bankKeeper bank.Keeper, validator sdk.AccAddress) (sdk.Coins, sdk.Tags, sdk.Error) {
+	rewards := k.GetRelayerRewards(ctx, validator)
+	if rewards.IsZero() {
+		return nil, nil, types.ErrNoRelayerRewards(k.codespace)
+	}
+	tags, err := k.SendCoinsFromModuleToAccount(ctx, bankKeeper, validator, rewards)
+	if err != nil {
+		return nil, nil, err
+	}
+	k.setRelayerRewards(ctx, validator, sdk.Coins{})
+	return rewards, tags, nil
+}
+
+// GetRelayerRewards returns the bridge fees a validator has earned and not withdrawn
+func (k Keeper) GetRelayerRewards(ctx sdk.Context, validator sdk.AccAddress) sdk.Coins {
+	return k.getCoins(ctx, types.GetRelayerRewardsKey(validator))
+}
+
+// SetRelayerRewards sets the bridge fees a validator has earned and not withdrawn
+func (k Keeper) SetRelayerRewards(ctx sdk.Context, reward types.RelayerReward) {
+	k.setRelayerRewards(ctx, reward.Validator, reward.Rewards)
+}
+
+func (k Keeper) setRelayerRewards(ctx sdk.Context, validator sdk.AccAddress, rewards sdk.Coins) {
+	k.setCoins(ctx, types.GetRelayerRewardsKey(validator), rewards)
+}
+
+// IterateRelayerRewards calls cb on the rewards of each validator which has some, until cb returns true
+func (k Keeper) IterateRelayerRewards(ctx sdk.Context, cb func(reward types.RelayerReward) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.RelayerRewardsKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var rewards sdk.Coins
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &rewards)
+		validator := sdk.AccAddress(iterator.Key()[len(types.RelayerRewardsKeyPrefix):])
+		if cb(types.NewRelayerReward(validator, rewards)) {
+			return
+		}
+	}
+}
+
+// GetOwedRelayerFees returns the relayer rewards which have not been withdrawn and the bridge fees left over by
+// rounding, which the ethbridge module account holds for the validators
+func (k Keeper) GetOwedRelayerFees(ctx sdk.Context) sdk.Coins {
+	owed := k.GetUndistributedFees(ctx)
+	k.IterateRelayerRewards(ctx, func(reward types.RelayerReward) bool {
+		owed = owed.Add(reward.Rewards)
+		return false
+	})
+	return owed
+}
+
+// GetUndistributedFees returns the bridge fees left over by rounding, which are added to the next fee
+func (k Keeper) GetUndistributedFees(ctx sdk.Context) sdk.Coins {
+	return k.getCoins(ctx, types.UndistributedFeesKey)
+}
+
+// SetUndistributedFees sets the bridge fees left over by rounding
+func (k Keeper) SetUndistributedFees(ctx sdk.Context, coins sdk.Coins) {
+	k.setCoins(ctx, types.UndistributedFeesKey, coins)
+}
+
+// GetMintFee returns the bridge fee deducted from the mint of a lock prophecy
+func (k Keeper) GetMintFee(ctx sdk.Context, oracleID string) sdk.Coins {
+	return k.getCoins(ctx, types.GetMintFeeKey(oracleID))
+}
+
+// SetMintFee records the bridge fee deducted from the mint of a lock prophecy, so that a revocation only claws back
+// what the receiver was sent
+func (k Keeper) SetMintFee(ctx sdk.Context, oracleID string, fee sdk.Coins) {
+	k.setCoins(ctx, types.GetMintFeeKey(oracleID), fee)
+}
+
+// IterateMintFees calls cb on the bridge fee deducted from the mint of each lock prophecy, until cb returns true
+func (k Keeper) IterateMintFees(ctx sdk.Context, cb func(mintFee types.MintFee) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.MintFeeKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var fee sdk.Coins
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &fee)
+		if cb(types.NewMintFee(string(iterator.Key()[len(types.MintFeeKeyPrefix):]), fee)) {
+			return
+		}
+	}
+}
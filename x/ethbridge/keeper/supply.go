@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+
+	"github.com/swishlabsco/cosmos-ethereum-bridge/x/ethbridge/types"
+)
+
+// MintCoins mints coins into the ethbridge module account, adding them to the minted coins and the bridged supply
+func (k Keeper) MintCoins(ctx sdk.Context, bankKeeper bank.Keeper, coins sdk.Coins) sdk.Error {
+	_, _, err := bankKeeper.AddCoins(ctx, types.ModuleAddress, coins)
+	if err != nil {
+		return err
+	}
+	k.SetMinted(ctx, k.GetMinted(ctx).Add(coins))
+	k.AddBridgedSupply(ctx, coins)
+	return nil
+}
+
+// BurnCoins burns coins held by the ethbridge module account, adding them to the burned coins and removing them
+// from the bridged supply
+func (k Keeper) BurnCoins(ctx sdk.Context, bankKeeper bank.Keeper, coins sdk.Coins) sdk.Error {
+	_, _, err := bankKeeper.SubtractCoins(ctx, types.ModuleAddress, coins)
+	if err != nil {
+		return err
+	}
+	k.SetBurned(ctx, k.GetBurned(ctx).Add(coins))
+	k.SubtractBridgedSupply(ctx, coins)
+	return nil
+}
+
+// SendCoinsFromModuleToAccount sends coins from the ethbridge module account to an account
+func (k Keeper) SendCoinsFromModuleToAccount(ctx sdk.Context, bankKeeper bank.Keeper, recipient sdk.AccAddress, coins sdk.Coins) (sdk.Tags, sdk.Error) {
+	return bankKeeper.SendCoins(ctx, types.ModuleAddress, recipient, coins)
+}
+
+// SendCoinsFromAccountToModule sends coins from an account to the ethbridge module account
+func (k Keeper) SendCoinsFromAccountToModule(ctx sdk.Context, bankKeeper bank.Keeper, sender sdk.AccAddress, coins sdk.Coins) (sdk.Tags, sdk.Error) {
+	return bankKeeper.SendCoins(ctx, sender, types.ModuleAddress, coins)
+}
+
+// GetMinted returns the coins the bridge has minted into its module account
+func (k Keeper) GetMinted(ctx sdk.Context) sdk.Coins {
+	return k.getCoins(ctx, types.MintedKey)
+}
+
+// SetMinted sets the coins the bridge has minted into its module account
+func (k Keeper) SetMinted(ctx sdk.Context, coins sdk.Coins) {
+	k.setCoins(ctx, types.MintedKey, coins)
+}
+
+// GetBurned returns the coins the bridge has burned from its module account
+func (k Keeper) GetBurned(ctx sdk.Context) sdk.Coins {
+	return k.getCoins(ctx, types.BurnedKey)
+}
+
+// SetBurned sets the coins the bridge has burned from its module account
+func (k Keeper) SetBurned(ctx sdk.Context, coins sdk.Coins) {
+	k.setCoins(ctx, types.BurnedKey, coins)
+}
+
+func (k Keeper) getCoins(ctx sdk.Context, key []byte) sdk.Coins {
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has(key) {
+		return sdk.Coins{}
+	}
+	var coins sdk.Coins
+	k.cdc.MustUnmarshalBinaryBare(store.Get(key), &coins)
+	return coins
+}
+
+func (k Keeper) setCoins(ctx sdk.Context, key []byte, coins sdk.Coins) {
+	store := ctx.KVStore(k.storeKey)
+	if coins.Empty() {
+		store.Delete(key)
+		return
+	}
+	store.Set(key, k.cdc.MustMarshalBinaryBare(coins))
+}
@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/stretchr/testify/require"
+
+	"github.com/swishlabsco/cosmos-ethereum-bridge/x/ethbridge/types"
+	oracleKeeperLib "github.com/swishlabsco/cosmos-ethereum-bridge/x/oracle/keeper"
+)
+
+// CreateTestKeepers creates an ethbridge Keeper alongside the OracleKeeper, BankKeeper and Context it is used with
+func CreateTestKeepers(t *testing.T, consensusNeeded float64, validatorPowers []int64) (sdk.Context, Keeper, oracleKeeperLib.Keeper, bank.Keeper, []sdk.ValAddress) {
+	ctx, keeper, oracleKeeper, bankKeeper, _, _, validatorAddresses := CreateTestKeepersWithAccounts(t, consensusNeeded, validatorPowers)
+	return ctx, keeper, oracleKeeper, bankKeeper, validatorAddresses
+}
+
+// CreateTestKeepersWithAccounts is CreateTestKeepers which also returns the AccountKeeper and FeeCollectionKeeper
+// holding the chain's coins
+func CreateTestKeepersWithAccounts(t *testing.T, consensusNeeded float64, validatorPowers []int64) (sdk.Context, Keeper, oracleKeeperLib.Keeper, bank.Keeper, auth.AccountKeeper, auth.FeeCollectionKeeper, []sdk.ValAddress) {
+	keyEthBridge := sdk.NewKVStoreKey(types.StoreKey)
+	keyFeeCollection := sdk.NewKVStoreKey(auth.FeeStoreKey)
+
+	ctx, accountKeeper, oracleKeeper, bankKeeper, paramsKeeper, validatorAddresses, err := oracleKeeperLib.CreateTestKeepersWithParams(t, consensusNeeded, validatorPowers, keyEthBridge, keyFeeCollection)
+	require.Nil(t, err)
+
+	cdc := oracleKeeperLib.MakeTestCodec()
+	keeper := NewKeeper(keyEthBridge, cdc, paramsKeeper.Subspace(types.DefaultParamspace), types.DefaultCodespace)
+	feeCollectionKeeper := auth.NewFeeCollectionKeeper(cdc, keyFeeCollection)
+
+	return ctx, keeper, oracleKeeper, bankKeeper, accountKeeper, feeCollectionKeeper, validatorAddresses
+}
@@ -0,0 +1,249 @@
+package ethbridge
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/swishlabsco/cosmos-ethereum-bridge/x/ethbridge/common"
+	"github.com/swishlabsco/cosmos-ethereum-bridge/x/ethbridge/keeper"
+	"github.com/swishlabsco/cosmos-ethereum-bridge/x/ethbridge/types"
+	"github.com/swishlabsco/cosmos-ethereum-bridge/x/oracle"
+)
+
+// legacyRevocationPrefix was prepended to a lock's version 0 prophecy id to form the id of the prophecy revoking it
+const legacyRevocationPrefix = "revoke"
+
+// legacyItemIDClaim reads the item id which version 0 lock claims carried in their ethereum provenance
+type legacyItemIDClaim struct {
+	EthereumProvenance struct {
+		ItemID string `json:"item_id"`
+	} `json:"ethereum_provenance"`
+}
+
+// BeginBlocker migrates the prophecy ids stored in the oracle to the current format the first time it runs on a
+// chain which stored prophecies under an earlier format. The bridge contracts map each ethereum chain id to the
+// address of the contract its locks were made on, which version 0 ids did not record. A chain cannot go on
+// without it, so the migration panics if a stored prophecy's chain has no contract. On a chain which minted coins
+// before it tracked the bridged supply, it also back-fills the bridged supply, which the supply invariant would
+// otherwise find short of the coins already minted.
+func BeginBlocker(ctx sdk.Context, bridgeKeeper keeper.Keeper, oracleKeeper oracle.Keeper, accountKeeper auth.AccountKeeper, feeCollectionKeeper auth.FeeCollectionKeeper, bridgeContracts map[int]string) {
+	if bridgeKeeper.GetProphecyIDVersion(ctx) < types.ProphecyIDVersion {
+		migrated, err := MigrateProphecyIDs(ctx, bridgeKeeper, oracleKeeper, bridgeContracts)
+		if err != nil {
+			panic(err)
+		}
+		bridgeKeeper.SetProphecyIDVersion(ctx, types.ProphecyIDVersion)
+		ctx.Logger().Info(fmt.Sprintf("migrated %d prophecies to version %d ids", migrated, types.ProphecyIDVersion))
+	}
+	if !bridgeKeeper.IsSupplyTracked(ctx) {
+		supply, err := BackfillBridgedSupply(ctx, bridgeKeeper, oracleKeeper, accountKeeper, feeCollectionKeeper)
+		if err != nil {
+			panic(err)
+		}
+		bridgeKeeper.SetSupplyTracked(ctx)
+		ctx.Logger().Info(fmt.Sprintf("back-filled bridged supply of %s", supply))
+	}
+}
+
+// BackfillBridgedSupply sets the bridged supply of each denom the bridge has minted to the coins of that denom held
+// by accounts and collected as fees. It is for chains which minted coins before they tracked the bridged supply,
+// where those coins can only be told apart from others by their denom. The denoms are those claimed by the final
+// claims of the oracle's prophecies and those already in the bridged supply. It returns the bridged supply.
+func BackfillBridgedSupply(ctx sdk.Context, bridgeKeeper keeper.Keeper, oracleKeeper oracle.Keeper, accountKeeper auth.AccountKeeper, feeCollectionKeeper auth.FeeCollectionKeeper) (sdk.Coins, sdk.Error) {
+	bridgedDenoms := make(map[string]bool)
+	bridgeKeeper.IterateBridgedSupply(ctx, func(denom string, _ sdk.Int) bool {
+		bridgedDenoms[denom] = true
+		return false
+	})
+	err := oracleKeeper.IterateProphecies(ctx, func(prophecy oracle.Prophecy) bool {
+		if prophecy.Status.FinalClaim == "" {
+			return false
+		}
+		oracleClaim, err := types.CreateOracleClaimFromOracleString(prophecy.Status.FinalClaim)
+		if err != nil {
+			return false
+		}
+		for _, coin := range oracleClaim.Amount {
+			bridgedDenoms[coin.Denom] = true
+		}
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	denoms := make([]string, 0, len(bridgedDenoms))
+	for denom := range bridgedDenoms {
+		denoms = append(denoms, denom)
+	}
+	sort.Strings(denoms)
+	held := keeper.GetHeldCoins(ctx, accountKeeper, feeCollectionKeeper)
+	for _, denom := range denoms {
+		bridgeKeeper.SetBridgedSupply(ctx, sdk.Coins{sdk.NewCoin(denom, held.AmountOf(denom))})
+	}
+	return bridgeKeeper.GetBridgedSupply(ctx), nil
+}
+
+// MigrateProphecyIDs moves the lock and revocation prophecies stored under version 0 ids to the store keys of their
+// ProphecyID, adding the ethereum sender and nonce which were part of the old id to each claim, and indexes each
+// lock by its nonce. Version 0 ids did not carry the item id either, so it is taken from the claims. Prophecies
+// whose claims do not agree on one keep their old id, where the nonce index still finds them. It returns the number
+// of prophecies migrated. Bridge status prophecies keep their ids.
+func MigrateProphecyIDs(ctx sdk.Context, bridgeKeeper keeper.Keeper, oracleKeeper oracle.Keeper, bridgeContracts map[int]string) (int, sdk.Error) {
+	var locks, revocations []oracle.Prophecy
+	err := oracleKeeper.IterateProphecies(ctx, func(prophecy oracle.Prophecy) bool {
+		if _, _, _, revocation, ok := parseLegacyProphecyID(prophecy.ID); ok && revocation {
+			revocations = append(revocations, prophecy)
+		} else if ok {
+			locks = append(locks, prophecy)
+		}
+		return false
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	itemIDs := make(map[string]string)
+	for _, prophecy := range append(locks, revocations...) {
+		oldID := prophecy.ID
+		ethereumChainID, nonce, ethereumSender, revocation, _ := parseLegacyProphecyID(oldID)
+		bridgeContract, ok := bridgeContracts[ethereumChainID]
+		if !ok {
+			return 0, sdk.ErrInternal(fmt.Sprintf("no bridge contract configured for ethereum chain %d of prophecy %s", ethereumChainID, oldID))
+		}
+
+		addSenderAndNonce := addSenderAndNonceToClaim
+		itemID := legacyItemID(prophecy)
+		if revocation {
+			addSenderAndNonce = addSenderAndNonceToRevocation
+			itemID = itemIDs[strings.TrimPrefix(oldID, legacyRevocationPrefix)]
+		}
+		prophecyID := types.NewProphecyID(ethereumChainID, bridgeContract, itemID)
+		switch {
+		case itemID == "":
+		case revocation:
+			prophecy.ID = string(prophecyID.RevocationKey())
+		default:
+			prophecy.ID = string(prophecyID.Key())
+		}
+
+		migrated, err := migrateClaims(prophecy, ethereumSender, nonce, addSenderAndNonce)
+		if err != nil {
+			return 0, err
+		}
+		err = oracleKeeper.MigrateProphecy(ctx, oldID, migrated)
+		if err != nil {
+			return 0, err
+		}
+		if !revocation {
+			itemIDs[oldID] = itemID
+			bridgeKeeper.SetNonceProphecy(ctx, types.NewNonceProphecy(nonce, prophecyID, migrated.ID))
+		}
+	}
+	return len(locks) + len(revocations), nil
+}
+
+// legacyItemID returns the item id of a version 0 lock prophecy, which is the one in its final claim, or else the
+// one all its claims agree on. It returns an empty string if the item id is not known.
+func legacyItemID(prophecy oracle.Prophecy) string {
+	if prophecy.Status.FinalClaim != "" {
+		return readLegacyItemID(prophecy.Status.FinalClaim)
+	}
+	itemID := ""
+	for _, claim := range prophecy.ValidatorClaims {
+		claimItemID := readLegacyItemID(claim)
+		if claimItemID == "" || (itemID != "" && claimItemID != itemID) {
+			return ""
+		}
+		itemID = claimItemID
+	}
+	return itemID
+}
+
+func readLegacyItemID(claim string) string {
+	var legacyClaim legacyItemIDClaim
+	if json.Unmarshal([]byte(claim), &legacyClaim) != nil || !common.IsValidEthHash(legacyClaim.EthereumProvenance.ItemID) {
+		return ""
+	}
+	return legacyClaim.EthereumProvenance.ItemID
+}
+
+// migrateClaims rewrites each claim on the prophecy and its final claim with the given function, re-indexing the
+// validators by their rewritten claims
+func migrateClaims(prophecy oracle.Prophecy, ethereumSender string, nonce sdk.Uint, addSenderAndNonce func(string, string, sdk.Uint) (string, sdk.Error)) (oracle.Prophecy, sdk.Error) {
+	migrated := oracle.NewProphecy(prophecy.ID)
+	migrated.Status = prophecy.Status
+	for validator, claim := range prophecy.ValidatorClaims {
+		newClaim, err := addSenderAndNonce(claim, ethereumSender, nonce)
+		if err != nil {
+			return oracle.Prophecy{}, err
+		}
+		migrated.ValidatorClaims[validator] = newClaim
+	}
+	for claim, validators := range prophecy.ClaimValidators {
+		newClaim, err := addSenderAndNonce(claim, ethereumSender, nonce)
+		if err != nil {
+			return oracle.Prophecy{}, err
+		}
+		migrated.ClaimValidators[newClaim] = append(migrated.ClaimValidators[newClaim], validators...)
+	}
+	if prophecy.Status.FinalClaim != "" {
+		finalClaim, err := addSenderAndNonce(prophecy.Status.FinalClaim, ethereumSender, nonce)
+		if err != nil {
+			return oracle.Prophecy{}, err
+		}
+		migrated.Status.FinalClaim = finalClaim
+	}
+	return migrated, nil
+}
+
+func addSenderAndNonceToClaim(claim string, ethereumSender string, nonce sdk.Uint) (string, sdk.Error) {
+	oracleClaim, err := types.CreateOracleClaimFromOracleString(claim)
+	if err != nil {
+		return "", err
+	}
+	oracleClaim.EthereumSender = ethereumSender
+	oracleClaim.Nonce = nonce
+	bz, _ := json.Marshal(oracleClaim)
+	return string(bz), nil
+}
+
+func addSenderAndNonceToRevocation(claim string, ethereumSender string, nonce sdk.Uint) (string, sdk.Error) {
+	var oracleRevocation types.OracleRevocation
+	errRes := json.Unmarshal([]byte(claim), &oracleRevocation)
+	if errRes != nil {
+		return "", sdk.ErrInternal(fmt.Sprintf("failed to parse revocation: %s", errRes))
+	}
+	oracleRevocation.EthereumSender = ethereumSender
+	oracleRevocation.Nonce = nonce
+	bz, _ := json.Marshal(oracleRevocation)
+	return string(bz), nil
+}
+
+// parseLegacyProphecyID returns the ethereum chain id, nonce and sender of a lock from a version 0 lock or
+// revocation prophecy id, which was the chain id, a colon, the nonce and the sender, and whether it is the id of a
+// revocation. It returns false if the id is not a version 0 lock or revocation prophecy id.
+func parseLegacyProphecyID(id string) (int, sdk.Uint, string, bool, bool) {
+	revocation := strings.HasPrefix(id, legacyRevocationPrefix)
+	id = strings.TrimPrefix(id, legacyRevocationPrefix)
+
+	separator := strings.Index(id, ":")
+	senderStart := strings.Index(id, "0x")
+	if separator <= 0 || senderStart < separator {
+		return 0, sdk.Uint{}, "", false, false
+	}
+	ethereumChainID, err := strconv.Atoi(id[:separator])
+	if err != nil {
+		return 0, sdk.Uint{}, "", false, false
+	}
+	nonce, nonceErr := types.ParseNonce(id[separator+1 : senderStart])
+	if nonceErr != nil {
+		return 0, sdk.Uint{}, "", false, false
+	}
+	return ethereumChainID, nonce, id[senderStart:], revocation, true
+}
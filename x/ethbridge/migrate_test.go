@@ -0,0 +1,211 @@
+package ethbridge
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	bridgeKeeperLib "github.com/swishlabsco/cosmos-ethereum-bridge/x/ethbridge/keeper"
+	"github.com/swishlabsco/cosmos-ethereum-bridge/x/ethbridge/types"
+	"github.com/swishlabsco/cosmos-ethereum-bridge/x/oracle"
+)
+
+// legacyOracleClaim is an oracle claim as stored with version 0 prophecy ids, which held the ethereum sender and
+// nonce, and whose provenance held the item id
+type legacyOracleClaim struct {
+	CosmosReceiver     sdk.AccAddress           `json:"cosmos_receiver"`
+	Amount             sdk.Coins                `json:"amount"`
+	EthereumProvenance legacyEthereumProvenance `json:"ethereum_provenance"`
+}
+
+type legacyEthereumProvenance struct {
+	ItemID string `json:"item_id"`
+	types.EthereumProvenance
+}
+
+func legacyClaimString(t *testing.T, claim types.EthBridgeClaim, itemID string) string {
+	bz, err := json.Marshal(legacyOracleClaim{claim.CosmosReceiver, claim.Amount, legacyEthereumProvenance{itemID, claim.EthereumProvenance}})
+	require.NoError(t, err)
+	return string(bz)
+}
+
+func TestMigrateProphecyIDs(t *testing.T) {
+	//Setup
+	cdc := codec.New()
+	ctx, bridgeKeeper, keeper, bankKeeper, accountKeeper, feeCollectionKeeper, validatorAddresses := bridgeKeeperLib.CreateTestKeepersWithAccounts(t, 0.7, []int64{3, 7})
+	accAddressVal1Pow3 := sdk.AccAddress(validatorAddresses[0])
+	accAddressVal2Pow7 := sdk.AccAddress(validatorAddresses[1])
+
+	//A pending lock, its pending revocation, a minted lock, a minted lock whose item id is not known and a bridge
+	//status prophecy, stored with version 0 ids
+	pendingClaim := types.CreateTestEthClaim(t, accAddressVal1Pow3, types.TestEthereumAddress, types.TestCoins)
+	pendingID := "3:1" + types.TestEthereumAddress
+	_, err := keeper.ProcessClaim(ctx, pendingID, validatorAddresses[0], legacyClaimString(t, pendingClaim, pendingClaim.ItemID))
+	require.NoError(t, err)
+	revocationID := legacyRevocationPrefix + pendingID
+	_, err = keeper.ProcessClaim(ctx, revocationID, validatorAddresses[0], `{"reason":"withdraw"}`)
+	require.NoError(t, err)
+
+	mintedClaim := types.CreateTestEthClaim(t, accAddressVal2Pow7, types.AltTestEthereumAddress, types.TestCoins)
+	mintedClaim.ItemID = types.AltTestEthereumItemID
+	mintedClaim.Nonce = sdk.NewUint(10)
+	mintedID := "3:10" + types.AltTestEthereumAddress
+	status, err := keeper.ProcessClaim(ctx, mintedID, validatorAddresses[1], legacyClaimString(t, mintedClaim, mintedClaim.ItemID))
+	require.NoError(t, err)
+	require.Equal(t, oracle.SuccessStatus, status.StatusText)
+
+	unknownItemClaim := types.CreateTestEthClaim(t, accAddressVal2Pow7, types.AltTestEthereumAddress, types.TestCoins)
+	unknownItemClaim.Nonce = sdk.NewUint(20)
+	unknownItemID := "3:20" + types.AltTestEthereumAddress
+	_, err = keeper.ProcessClaim(ctx, unknownItemID, validatorAddresses[1], legacyClaimString(t, unknownItemClaim, ""))
+	require.NoError(t, err)
+
+	statusClaim := types.NewBridgeStatusClaim(types.TestEthereumChainID, false, 100, accAddressVal1Pow3)
+	statusID, validator, claimString := types.CreateOracleClaimFromBridgeStatusClaim(cdc, statusClaim)
+	_, err = keeper.ProcessClaim(ctx, statusID, validator, claimString)
+	require.NoError(t, err)
+
+	bridgeContracts := map[int]string{types.TestEthereumChainID: types.TestBridgeContract}
+	BeginBlocker(ctx, bridgeKeeper, keeper, accountKeeper, feeCollectionKeeper, bridgeContracts)
+	require.Equal(t, types.ProphecyIDVersion, bridgeKeeper.GetProphecyIDVersion(ctx))
+
+	//The prophecies with known item ids are moved to their new ids, with claims equal to those made with the new ids
+	for _, oldID := range []string{pendingID, revocationID, mintedID} {
+		_, err = keeper.GetProphecy(ctx, oldID)
+		require.Error(t, err)
+	}
+	_, _, pendingClaimString := types.CreateOracleClaimFromEthClaim(cdc, pendingClaim)
+	prophecy, err := keeper.GetProphecy(ctx, string(pendingClaim.ProphecyID().Key()))
+	require.NoError(t, err)
+	require.Equal(t, oracle.PendingStatus, prophecy.Status.StatusText)
+	require.Equal(t, pendingClaimString, prophecy.ValidatorClaims[validatorAddresses[0].String()])
+	require.Equal(t, []sdk.ValAddress{validatorAddresses[0]}, prophecy.ClaimValidators[pendingClaimString])
+
+	revocation := types.NewEthBridgeRevocation(types.TestEthereumChainID, types.TestBridgeContract, types.TestEthereumItemID,
+		sdk.NewUint(types.TestNonce), types.TestEthereumAddress, accAddressVal1Pow3, types.RevocationReasonWithdraw)
+	revocationOracleID, _, revocationString, _ := types.CreateOracleClaimFromEthRevocation(cdc, revocation)
+	prophecy, err = keeper.GetProphecy(ctx, revocationOracleID)
+	require.NoError(t, err)
+	require.Equal(t, revocationString, prophecy.ValidatorClaims[validatorAddresses[0].String()])
+
+	_, _, mintedClaimString := types.CreateOracleClaimFromEthClaim(cdc, mintedClaim)
+	prophecy, err = keeper.GetProphecy(ctx, string(mintedClaim.ProphecyID().Key()))
+	require.NoError(t, err)
+	require.Equal(t, oracle.SuccessStatus, prophecy.Status.StatusText)
+	require.Equal(t, mintedClaimString, prophecy.Status.FinalClaim)
+
+	//The prophecy whose item id is not known keeps its id, and is found through its nonce
+	prophecy, err = keeper.GetProphecy(ctx, unknownItemID)
+	require.NoError(t, err)
+	oracleClaim, err := types.CreateOracleClaimFromOracleString(prophecy.Status.FinalClaim)
+	require.NoError(t, err)
+	require.Equal(t, types.AltTestEthereumAddress, oracleClaim.EthereumSender)
+	require.Equal(t, unknownItemClaim.Nonce, oracleClaim.Nonce)
+
+	nonceProphecies := bridgeKeeper.GetNonceProphecies(ctx, types.TestEthereumChainID, types.TestBridgeContract, unknownItemClaim.Nonce)
+	require.Equal(t, []types.NonceProphecy{types.NewNonceProphecy(unknownItemClaim.Nonce,
+		types.NewProphecyID(types.TestEthereumChainID, types.TestBridgeContract, ""), unknownItemID)}, nonceProphecies)
+	nonceProphecies = bridgeKeeper.GetNonceProphecies(ctx, types.TestEthereumChainID, types.TestBridgeContract, mintedClaim.Nonce)
+	require.Equal(t, []types.NonceProphecy{types.NewNonceProphecy(mintedClaim.Nonce,
+		mintedClaim.ProphecyID(), string(mintedClaim.ProphecyID().Key()))}, nonceProphecies)
+
+	_, err = keeper.GetProphecy(ctx, statusID)
+	require.NoError(t, err)
+
+	//The migrated pending prophecy completes with a claim made with the new id
+	handler := NewHandler(bridgeKeeper, keeper, bankKeeper, cdc, types.DefaultCodespace)
+	res := handler(ctx, types.CreateTestEthMsg(t, accAddressVal2Pow7))
+	require.True(t, res.IsOK())
+	require.Equal(t, oracle.SuccessStatus, res.Log)
+
+	//The nonce of the prophecy whose item id is not known cannot be minted again, and the prophecy can be revoked
+	itemID := "0x" + strings.Repeat("ab", 32)
+	remintMsg := types.NewMsgMakeEthBridgeClaim(unknownItemClaim)
+	remintMsg.ItemID = itemID
+	res = handler(ctx, remintMsg)
+	require.False(t, res.IsOK())
+	require.Equal(t, types.CodeNonceAlreadyMinted, res.Code)
+
+	revokeMsg := types.NewMsgRevokeEthBridgeClaim(types.NewEthBridgeRevocation(types.TestEthereumChainID, types.TestBridgeContract,
+		itemID, unknownItemClaim.Nonce, types.AltTestEthereumAddress, accAddressVal2Pow7, types.RevocationReasonUnlock))
+	res = handler(ctx, revokeMsg)
+	require.True(t, res.IsOK())
+	require.Equal(t, oracle.SuccessStatus, res.Log)
+	prophecy, err = keeper.GetProphecy(ctx, unknownItemID)
+	require.NoError(t, err)
+	require.Equal(t, oracle.RevokedStatus, prophecy.Status.StatusText)
+
+	//The migration only runs once, so it no longer needs the bridge contracts
+	require.NotPanics(t, func() {
+		BeginBlocker(ctx, bridgeKeeper, keeper, accountKeeper, feeCollectionKeeper, nil)
+	})
+}
+
+func TestMigrateProphecyIDsWithoutBridgeContract(t *testing.T) {
+	ctx, bridgeKeeper, keeper, _, accountKeeper, feeCollectionKeeper, validatorAddresses := bridgeKeeperLib.CreateTestKeepersWithAccounts(t, 0.7, []int64{3, 7})
+	claim := types.CreateTestEthClaim(t, sdk.AccAddress(validatorAddresses[0]), types.TestEthereumAddress, types.TestCoins)
+	_, err := keeper.ProcessClaim(ctx, "3:1"+types.TestEthereumAddress, validatorAddresses[0], legacyClaimString(t, claim, claim.ItemID))
+	require.NoError(t, err)
+
+	//Prophecies cannot be migrated without the contract of their chain
+	_, err = MigrateProphecyIDs(ctx, bridgeKeeper, keeper, map[int]string{types.TestEthereumChainID + 1: types.TestBridgeContract})
+	require.Error(t, err)
+	require.Panics(t, func() {
+		BeginBlocker(ctx, bridgeKeeper, keeper, accountKeeper, feeCollectionKeeper, nil)
+	})
+	require.Equal(t, uint64(0), bridgeKeeper.GetProphecyIDVersion(ctx))
+}
+
+func TestBackfillBridgedSupply(t *testing.T) {
+	ctx, bridgeKeeper, keeper, bankKeeper, accountKeeper, feeCollectionKeeper, validatorAddresses := bridgeKeeperLib.CreateTestKeepersWithAccounts(t, 0.7, []int64{3, 7})
+	invariant := bridgeKeeperLib.SupplyInvariant(bridgeKeeper, accountKeeper, feeCollectionKeeper)
+
+	//Coins minted by a lock before the bridged supply was tracked, part of which were paid as fees, alongside coins
+	//of a denom the bridge never minted
+	claim := types.CreateTestEthClaim(t, sdk.AccAddress(validatorAddresses[1]), types.TestEthereumAddress, types.TestCoins)
+	status, err := keeper.ProcessClaim(ctx, "3:1"+types.TestEthereumAddress, validatorAddresses[1], legacyClaimString(t, claim, claim.ItemID))
+	require.NoError(t, err)
+	require.Equal(t, oracle.SuccessStatus, status.StatusText)
+	receiverAddress, addressErr := sdk.AccAddressFromBech32(types.TestAddress)
+	require.NoError(t, addressErr)
+	_, _, mintErr := bankKeeper.AddCoins(ctx, receiverAddress, sdk.Coins{sdk.NewInt64Coin("ethereum", 8), sdk.NewInt64Coin("stake", 5)})
+	require.Nil(t, mintErr)
+	feeCollectionKeeper.AddCollectedFees(ctx, sdk.Coins{sdk.NewInt64Coin("ethereum", 2)})
+
+	//A later mint is tracked, which would break the invariant without the back-fill
+	bridgeKeeper.AddBridgedSupply(ctx, sdk.Coins{sdk.NewInt64Coin("ethereum", 1)})
+	_, _, mintErr = bankKeeper.AddCoins(ctx, receiverAddress, sdk.Coins{sdk.NewInt64Coin("ethereum", 1)})
+	require.Nil(t, mintErr)
+	require.Error(t, invariant(ctx))
+
+	bridgeContracts := map[int]string{types.TestEthereumChainID: types.TestBridgeContract}
+	BeginBlocker(ctx, bridgeKeeper, keeper, accountKeeper, feeCollectionKeeper, bridgeContracts)
+	require.True(t, bridgeKeeper.IsSupplyTracked(ctx))
+	require.Equal(t, "11ethereum", bridgeKeeper.GetBridgedSupply(ctx).String())
+	require.NoError(t, invariant(ctx))
+
+	//The back-fill only runs once, so coins of a bridged denom which the bridge did not mint still break the
+	//invariant
+	_, _, mintErr = bankKeeper.AddCoins(ctx, receiverAddress, sdk.Coins{sdk.NewInt64Coin("ethereum", 1)})
+	require.Nil(t, mintErr)
+	BeginBlocker(ctx, bridgeKeeper, keeper, accountKeeper, feeCollectionKeeper, bridgeContracts)
+	require.Equal(t, "11ethereum", bridgeKeeper.GetBridgedSupply(ctx).String())
+	require.Error(t, invariant(ctx))
+}
+
+func TestBackfillBridgedSupplyAfterInitGenesis(t *testing.T) {
+	ctx, bridgeKeeper, keeper, bankKeeper, accountKeeper, feeCollectionKeeper, _ := bridgeKeeperLib.CreateTestKeepersWithAccounts(t, 0.7, []int64{3, 7})
+	InitGenesis(ctx, bridgeKeeper, types.DefaultGenesisState())
+	require.True(t, bridgeKeeper.IsSupplyTracked(ctx))
+
+	//A chain which tracked the bridged supply from genesis has nothing to back-fill
+	bridgeKeeper.AddBridgedSupply(ctx, sdk.Coins{sdk.NewInt64Coin("ethereum", 1)})
+	_, _, mintErr := bankKeeper.AddCoins(ctx, types.ModuleAddress, sdk.Coins{sdk.NewInt64Coin("ethereum", 2)})
+	require.Nil(t, mintErr)
+	BeginBlocker(ctx, bridgeKeeper, keeper, accountKeeper, feeCollectionKeeper, nil)
+	require.Equal(t, "1ethereum", bridgeKeeper.GetBridgedSupply(ctx).String())
+}
@@ -2,10 +2,11 @@ package querier
 
 import (
 	"fmt"
-	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/swishlabsco/cosmos-ethereum-bridge/x/ethbridge/common"
+	bridgekeeper "github.com/swishlabsco/cosmos-ethereum-bridge/x/ethbridge/keeper"
 	"github.com/swishlabsco/cosmos-ethereum-bridge/x/ethbridge/types"
 	keep "github.com/swishlabsco/cosmos-ethereum-bridge/x/oracle/keeper"
 	oracletypes "github.com/swishlabsco/cosmos-ethereum-bridge/x/oracle/types"
@@ -14,15 +15,60 @@ import (
 
 //query endpoints supported by the oracle Querier
 const (
-	QueryEthProphecy = "prophecies"
+	QueryEthProphecy       = "prophecies"
+	QueryPendingProphecies = "pending-prophecies"
+	QueryNonceProphecies   = "nonce-prophecies"
+	QueryNonceGaps         = "nonce-gaps"
+	QueryBridgeStatus      = "status"
+	QueryBridgedSupply     = "bridged-supply"
+	QueryMintingStatus     = "minting-status"
+	QueryPausedMints       = "paused-mints"
+	QuerySupply            = "supply"
+	QueryParams            = "params"
+	QueryRelayerRewards    = "relayer-rewards"
+	QueryFeePool           = "fee-pool"
+	QueryMintLimits        = "mint-limits"
+	QueryDelayedMints      = "delayed-mints"
+	QueryEscrowedMints     = "escrowed-mints"
+	QueryRefunds           = "refunds"
 )
 
 // NewQuerier is the module level router for state queries
-func NewQuerier(keeper keep.Keeper, cdc *codec.Codec, codespace sdk.CodespaceType) sdk.Querier {
+func NewQuerier(bridgeKeeper bridgekeeper.Keeper, keeper keep.Keeper, cdc *codec.Codec, codespace sdk.CodespaceType) sdk.Querier {
 	return func(ctx sdk.Context, path []string, req abci.RequestQuery) (res []byte, err sdk.Error) {
 		switch path[0] {
 		case QueryEthProphecy:
 			return queryEthProphecy(ctx, cdc, req, keeper, codespace)
+		case QueryPendingProphecies:
+			return queryPendingProphecies(ctx, cdc, req, keeper)
+		case QueryNonceProphecies:
+			return queryNonceProphecies(ctx, cdc, req, bridgeKeeper, keeper)
+		case QueryNonceGaps:
+			return queryNonceGaps(ctx, cdc, req, bridgeKeeper, keeper)
+		case QueryBridgeStatus:
+			return queryBridgeStatus(ctx, cdc, req, bridgeKeeper)
+		case QueryBridgedSupply:
+			return queryBridgedSupply(ctx, cdc, bridgeKeeper)
+		case QueryMintingStatus:
+			return queryMintingStatus(ctx, cdc, bridgeKeeper)
+		case QueryPausedMints:
+			return queryPausedMints(ctx, cdc, bridgeKeeper, keeper)
+		case QuerySupply:
+			return querySupply(ctx, cdc, bridgeKeeper)
+		case QueryParams:
+			return queryParams(ctx, cdc, bridgeKeeper)
+		case QueryRelayerRewards:
+			return queryRelayerRewards(ctx, cdc, req, bridgeKeeper)
+		case QueryFeePool:
+			return queryFeePool(ctx, cdc, bridgeKeeper)
+		case QueryMintLimits:
+			return queryMintLimits(ctx, cdc, bridgeKeeper)
+		case QueryDelayedMints:
+			return queryDelayedMints(ctx, cdc, bridgeKeeper, keeper)
+		case QueryEscrowedMints:
+			return queryEscrowedMints(ctx, cdc, bridgeKeeper, keeper)
+		case QueryRefunds:
+			return queryRefunds(ctx, cdc, req, bridgeKeeper, codespace)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown ethbridge query endpoint")
 		}
@@ -37,18 +83,18 @@ func queryEthProphecy(ctx sdk.Context, cdc *codec.Codec, req abci.RequestQuery,
 		return []byte{}, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
 	}
 
-	id := strconv.Itoa(params.Nonce) + params.EthereumSender
-	prophecy, err := keeper.GetProphecy(ctx, id)
+	id := types.NewProphecyID(params.ProphecyID.EthereumChainID, params.ProphecyID.BridgeContractAddress, params.ProphecyID.ItemID)
+	prophecy, err := keeper.GetProphecy(ctx, string(id.Key()))
 	if err != nil {
-		return []byte{}, oracletypes.ErrProphecyNotFound(codespace)
+		return []byte{}, oracletypes.ErrProphecyNotFound(keeper.Codespace())
 	}
 
-	bridgeClaims, err2 := MapOracleClaimsToEthBridgeClaims(params.Nonce, params.EthereumSender, prophecy.ValidatorClaims, types.CreateEthClaimFromOracleString)
+	bridgeClaims, err2 := MapOracleClaimsToEthBridgeClaims(id, prophecy.ValidatorClaims, types.CreateEthClaimFromOracleString)
 	if err2 != nil {
 		return []byte{}, err2
 	}
 
-	response := types.NewQueryEthProphecyResponse(prophecy.ID, prophecy.Status, bridgeClaims)
+	response := types.NewQueryEthProphecyResponse(id, prophecy.Status, bridgeClaims)
 
 	bz, err3 := codec.MarshalJSONIndent(cdc, response)
 	if err3 != nil {
@@ -58,7 +104,357 @@ func queryEthProphecy(ctx sdk.Context, cdc *codec.Codec, req abci.RequestQuery,
 	return bz, nil
 }
 
-func MapOracleClaimsToEthBridgeClaims(nonce int, ethereumSender string, oracleValidatorClaims map[string]string, f func(int, string, sdk.ValAddress, string) (types.EthBridgeClaim, sdk.Error)) ([]types.EthBridgeClaim, sdk.Error) {
+// queryPendingProphecies returns the lock prophecies on an ethereum chain which are still pending, with their claims
+func queryPendingProphecies(ctx sdk.Context, cdc *codec.Codec, req abci.RequestQuery, keeper keep.Keeper) (res []byte, err sdk.Error) {
+	var params types.QueryPendingPropheciesParams
+
+	errRes := cdc.UnmarshalJSON(req.Data, &params)
+	if errRes != nil {
+		return []byte{}, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", errRes))
+	}
+
+	response := []types.QueryEthProphecyResponse{}
+	var mapErr sdk.Error
+	prefix := types.ChainProphecyKeyPrefix(params.EthereumChainID)
+	err = keeper.IteratePropheciesWithPrefix(ctx, prefix, func(prophecy oracletypes.Prophecy) bool {
+		id, ok := types.ProphecyIDFromKey([]byte(prophecy.ID))
+		if !ok || prophecy.Status.StatusText != oracletypes.PendingStatusText {
+			return false
+		}
+
+		bridgeClaims, err := MapOracleClaimsToEthBridgeClaims(id, prophecy.ValidatorClaims, types.CreateEthClaimFromOracleString)
+		if err != nil {
+			mapErr = err
+			return true
+		}
+		response = append(response, types.NewQueryEthProphecyResponse(id, prophecy.Status, bridgeClaims))
+		return false
+	})
+	if err != nil {
+		return []byte{}, err
+	}
+	if mapErr != nil {
+		return []byte{}, mapErr
+	}
+
+	bz, err2 := codec.MarshalJSONIndent(cdc, response)
+	if err2 != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return bz, nil
+}
+
+// queryNonceProphecies returns the prophecies claiming a nonce of a bridge contract, with their claims. A nonce has
+// more than one prophecy only if validators claimed it for different items.
+func queryNonceProphecies(ctx sdk.Context, cdc *codec.Codec, req abci.RequestQuery, bridgeKeeper bridgekeeper.Keeper, keeper keep.Keeper) (res []byte, err sdk.Error) {
+	var params types.QueryNoncePropheciesParams
+
+	errRes := cdc.UnmarshalJSON(req.Data, &params)
+	if errRes != nil {
+		return []byte{}, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", errRes))
+	}
+
+	response := []types.QueryEthProphecyResponse{}
+	nonceProphecies := bridgeKeeper.GetNonceProphecies(ctx, params.EthereumChainID, params.BridgeContractAddress, params.Nonce)
+	for _, nonceProphecy := range nonceProphecies {
+		prophecy, err := keeper.GetProphecy(ctx, nonceProphecy.OracleID)
+		if err != nil {
+			return []byte{}, err
+		}
+
+		bridgeClaims, err := MapOracleClaimsToEthBridgeClaims(nonceProphecy.ProphecyID, prophecy.ValidatorClaims, types.CreateEthClaimFromOracleString)
+		if err != nil {
+			return []byte{}, err
+		}
+		response = append(response, types.NewQueryEthProphecyResponse(nonceProphecy.ProphecyID, prophecy.Status, bridgeClaims))
+	}
+
+	bz, err2 := codec.MarshalJSONIndent(cdc, response)
+	if err2 != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return bz, nil
+}
+
+// queryNonceGaps returns the last finalized nonce of a bridge contract, the highest nonce claimed on it, the nonces
+// in between which have not been finalized and the nonces whose mints are queued behind them
+func queryNonceGaps(ctx sdk.Context, cdc *codec.Codec, req abci.RequestQuery, bridgeKeeper bridgekeeper.Keeper, keeper keep.Keeper) (res []byte, err sdk.Error) {
+	var params types.QueryNonceGapsParams
+
+	errRes := cdc.UnmarshalJSON(req.Data, &params)
+	if errRes != nil {
+		return []byte{}, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", errRes))
+	}
+	limit := params.Limit
+	if limit <= 0 {
+		limit = types.DefaultNonceGapsLimit
+	}
+
+	lastFinalized := bridgeKeeper.GetLastFinalizedNonce(ctx, params.EthereumChainID, params.BridgeContractAddress)
+	gaps, highestClaimed := bridgeKeeper.NonceGaps(ctx, keeper, params.EthereumChainID, params.BridgeContractAddress, limit)
+	queuedNonces := []sdk.Uint{}
+	bridgeKeeper.IterateQueuedMints(ctx, params.EthereumChainID, params.BridgeContractAddress, func(queuedMint types.NonceProphecy) bool {
+		queuedNonces = append(queuedNonces, queuedMint.Nonce)
+		return false
+	})
+	response := types.NewQueryNonceGapsResponse(lastFinalized, highestClaimed, gaps, queuedNonces)
+
+	bz, err2 := codec.MarshalJSONIndent(cdc, response)
+	if err2 != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return bz, nil
+}
+
+func queryBridgeStatus(ctx sdk.Context, cdc *codec.Codec, req abci.RequestQuery, bridgeKeeper bridgekeeper.Keeper) (res []byte, err sdk.Error) {
+	var params types.QueryBridgeStatusParams
+
+	errRes := cdc.UnmarshalJSON(req.Data, &params)
+	if errRes != nil {
+		return []byte{}, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", errRes))
+	}
+
+	status := bridgeKeeper.GetBridgeStatus(ctx, params.EthereumChainID)
+
+	bz, err2 := codec.MarshalJSONIndent(cdc, status)
+	if err2 != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return bz, nil
+}
+
+// queryBridgedSupply returns the coins minted by the bridge which have not been clawed back
+func queryBridgedSupply(ctx sdk.Context, cdc *codec.Codec, bridgeKeeper bridgekeeper.Keeper) (res []byte, err sdk.Error) {
+	supply := bridgeKeeper.GetBridgedSupply(ctx)
+
+	bz, err2 := codec.MarshalJSONIndent(cdc, supply)
+	if err2 != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return bz, nil
+}
+
+// querySupply returns the ethbridge module account and the coins the bridge has minted into it, burned from it and
+// left in circulation
+func querySupply(ctx sdk.Context, cdc *codec.Codec, bridgeKeeper bridgekeeper.Keeper) (res []byte, err sdk.Error) {
+	response := types.NewQuerySupplyResponse(types.ModuleAddress, bridgeKeeper.GetMinted(ctx), bridgeKeeper.GetBurned(ctx), bridgeKeeper.GetBridgedSupply(ctx))
+
+	bz, err2 := codec.MarshalJSONIndent(cdc, response)
+	if err2 != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return bz, nil
+}
+
+// queryParams returns the ethbridge params
+func queryParams(ctx sdk.Context, cdc *codec.Codec, bridgeKeeper bridgekeeper.Keeper) (res []byte, err sdk.Error) {
+	params := bridgeKeeper.GetParams(ctx)
+
+	bz, err2 := codec.MarshalJSONIndent(cdc, params)
+	if err2 != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return bz, nil
+}
+
+// queryRelayerRewards returns the bridge fees a validator has earned by relaying claims and not withdrawn
+func queryRelayerRewards(ctx sdk.Context, cdc *codec.Codec, req abci.RequestQuery, bridgeKeeper bridgekeeper.Keeper) (res []byte, err sdk.Error) {
+	var params types.QueryRelayerRewardsParams
+
+	errRes := cdc.UnmarshalJSON(req.Data, &params)
+	if errRes != nil {
+		return []byte{}, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", errRes))
+	}
+
+	reward := types.NewRelayerReward(params.Validator, bridgeKeeper.GetRelayerRewards(ctx, params.Validator))
+
+	bz, err2 := codec.MarshalJSONIndent(cdc, reward)
+	if err2 != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return bz, nil
+}
+
+// queryRefunds returns the locks an ethereum sender made to invalid cosmos receivers, which can be unlocked back to
+// the sender on ethereum while their status is refundable
+func queryRefunds(ctx sdk.Context, cdc *codec.Codec, req abci.RequestQuery, bridgeKeeper bridgekeeper.Keeper, codespace sdk.CodespaceType) (res []byte, err sdk.Error) {
+	var params types.QueryRefundsParams
+
+	errRes := cdc.UnmarshalJSON(req.Data, &params)
+	if errRes != nil {
+		return []byte{}, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", errRes))
+	}
+	if !common.IsValidEthAddress(params.EthereumSender) {
+		return []byte{}, types.ErrInvalidEthAddress(codespace)
+	}
+
+	bz, err2 := codec.MarshalJSONIndent(cdc, bridgeKeeper.GetRefunds(ctx, params.EthereumSender))
+	if err2 != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return bz, nil
+}
+
+// queryFeePool returns the bridge fees held by the ethbridge module account, by the validator they are owed to
+func queryFeePool(ctx sdk.Context, cdc *codec.Codec, bridgeKeeper bridgekeeper.Keeper) (res []byte, err sdk.Error) {
+	rewards := []types.RelayerReward{}
+	bridgeKeeper.IterateRelayerRewards(ctx, func(reward types.RelayerReward) bool {
+		rewards = append(rewards, reward)
+		return false
+	})
+	response := types.NewQueryFeePoolResponse(rewards, bridgeKeeper.GetUndistributedFees(ctx))
+
+	bz, err2 := codec.MarshalJSONIndent(cdc, response)
+	if err2 != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return bz, nil
+}
+
+// queryMintingStatus returns whether minting is paused, the admin allowed to pause it and the number of mints held
+func queryMintingStatus(ctx sdk.Context, cdc *codec.Codec, bridgeKeeper bridgekeeper.Keeper) (res []byte, err sdk.Error) {
+	pausedMints := 0
+	bridgeKeeper.IteratePausedMints(ctx, func(types.NonceProphecy) bool {
+		pausedMints++
+		return false
+	})
+	response := types.NewQueryMintingStatusResponse(bridgeKeeper.IsMintingPaused(ctx), bridgeKeeper.GetParams(ctx).Admin, pausedMints)
+
+	bz, err2 := codec.MarshalJSONIndent(cdc, response)
+	if err2 != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return bz, nil
+}
+
+// queryPausedMints returns the successful prophecies whose coins are held while minting is paused, with their claims
+func queryPausedMints(ctx sdk.Context, cdc *codec.Codec, bridgeKeeper bridgekeeper.Keeper, keeper keep.Keeper) (res []byte, err sdk.Error) {
+	response := []types.QueryEthProphecyResponse{}
+	var iterErr sdk.Error
+	bridgeKeeper.IteratePausedMints(ctx, func(pausedMint types.NonceProphecy) bool {
+		prophecy, err := keeper.GetProphecy(ctx, pausedMint.OracleID)
+		if err != nil {
+			iterErr = err
+			return true
+		}
+
+		bridgeClaims, err := MapOracleClaimsToEthBridgeClaims(pausedMint.ProphecyID, prophecy.ValidatorClaims, types.CreateEthClaimFromOracleString)
+		if err != nil {
+			iterErr = err
+			return true
+		}
+		response = append(response, types.NewQueryEthProphecyResponse(pausedMint.ProphecyID, prophecy.Status, bridgeClaims))
+		return false
+	})
+	if iterErr != nil {
+		return []byte{}, iterErr
+	}
+
+	bz, err2 := codec.MarshalJSONIndent(cdc, response)
+	if err2 != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return bz, nil
+}
+
+func queryMintLimits(ctx sdk.Context, cdc *codec.Codec, bridgeKeeper bridgekeeper.Keeper) (res []byte, err sdk.Error) {
+	delayedMints := 0
+	bridgeKeeper.IterateDelayedMints(ctx, func(types.DelayedMint) bool {
+		delayedMints++
+		return false
+	})
+	windowMinted, _ := bridgeKeeper.GetWindowMinted(ctx, nil)
+	response := types.NewQueryMintLimitsResponse(bridgeKeeper.GetParams(ctx).MintLimits(), windowMinted, delayedMints)
+
+	bz, err2 := codec.MarshalJSONIndent(cdc, response)
+	if err2 != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return bz, nil
+}
+
+// queryDelayedMints returns the successful prophecies whose coins are delayed by the mint limits, with their claims
+// and the block they were delayed at
+func queryDelayedMints(ctx sdk.Context, cdc *codec.Codec, bridgeKeeper bridgekeeper.Keeper, keeper keep.Keeper) (res []byte, err sdk.Error) {
+	response := []types.QueryDelayedMintResponse{}
+	var iterErr sdk.Error
+	bridgeKeeper.IterateDelayedMints(ctx, func(delayedMint types.DelayedMint) bool {
+		prophecy, err := keeper.GetProphecy(ctx, delayedMint.NonceProphecy.OracleID)
+		if err != nil {
+			iterErr = err
+			return true
+		}
+
+		prophecyID := delayedMint.NonceProphecy.ProphecyID
+		bridgeClaims, err := MapOracleClaimsToEthBridgeClaims(prophecyID, prophecy.ValidatorClaims, types.CreateEthClaimFromOracleString)
+		if err != nil {
+			iterErr = err
+			return true
+		}
+		prophecyResponse := types.NewQueryEthProphecyResponse(prophecyID, prophecy.Status, bridgeClaims)
+		response = append(response, types.NewQueryDelayedMintResponse(prophecyResponse, delayedMint.DelayedAt))
+		return false
+	})
+	if iterErr != nil {
+		return []byte{}, iterErr
+	}
+
+	bz, err2 := codec.MarshalJSONIndent(cdc, response)
+	if err2 != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return bz, nil
+}
+
+// queryEscrowedMints returns the successful prophecies whose coins are held in escrow, with their claims, release
+// height and the validators disputing them
+func queryEscrowedMints(ctx sdk.Context, cdc *codec.Codec, bridgeKeeper bridgekeeper.Keeper, keeper keep.Keeper) (res []byte, err sdk.Error) {
+	response := []types.QueryEscrowedMintResponse{}
+	var iterErr sdk.Error
+	bridgeKeeper.IterateEscrowedMints(ctx, func(escrowedMint types.EscrowedMint) bool {
+		prophecy, err := keeper.GetProphecy(ctx, escrowedMint.NonceProphecy.OracleID)
+		if err != nil {
+			iterErr = err
+			return true
+		}
+
+		prophecyID := escrowedMint.NonceProphecy.ProphecyID
+		bridgeClaims, err := MapOracleClaimsToEthBridgeClaims(prophecyID, prophecy.ValidatorClaims, types.CreateEthClaimFromOracleString)
+		if err != nil {
+			iterErr = err
+			return true
+		}
+		prophecyResponse := types.NewQueryEthProphecyResponse(prophecyID, prophecy.Status, bridgeClaims)
+		response = append(response, types.NewQueryEscrowedMintResponse(prophecyResponse, escrowedMint.ReleaseHeight, escrowedMint.Disputes))
+		return false
+	})
+	if iterErr != nil {
+		return []byte{}, iterErr
+	}
+
+	bz, err2 := codec.MarshalJSONIndent(cdc, response)
+	if err2 != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return bz, nil
+}
+
+func MapOracleClaimsToEthBridgeClaims(prophecyID types.ProphecyID, oracleValidatorClaims map[string]string, f func(types.ProphecyID, sdk.ValAddress, string) (types.EthBridgeClaim, sdk.Error)) ([]types.EthBridgeClaim, sdk.Error) {
 	mappedClaims := make([]types.EthBridgeClaim, len(oracleValidatorClaims))
 	i := 0
 	for validatorBech32, validatorClaim := range oracleValidatorClaims {
@@ -66,7 +462,7 @@ func MapOracleClaimsToEthBridgeClaims(nonce int, ethereumSender string, oracleVa
 		if parseErr != nil {
 			return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse claim: %s", parseErr))
 		}
-		mappedClaim, err := f(nonce, ethereumSender, validatorAddress, validatorClaim)
+		mappedClaim, err := f(prophecyID, validatorAddress, validatorClaim)
 		if err != nil {
 			return nil, err
 		}
@@ -2,6 +2,7 @@ package querier
 
 import (
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -9,8 +10,10 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	bridgeKeeperLib "github.com/swishlabsco/cosmos-ethereum-bridge/x/ethbridge/keeper"
 	"github.com/swishlabsco/cosmos-ethereum-bridge/x/ethbridge/types"
 	keeperLib "github.com/swishlabsco/cosmos-ethereum-bridge/x/oracle/keeper"
+	oracletypes "github.com/swishlabsco/cosmos-ethereum-bridge/x/oracle/types"
 )
 
 var (
@@ -19,14 +22,14 @@ var (
 
 func TestNewQuerier(t *testing.T) {
 	cdc := codec.New()
-	ctx, _, keeper, _, _, _ := keeperLib.CreateTestKeepers(t, 0.7, []int64{3, 3})
+	ctx, bridgeKeeper, keeper, _, _ := bridgeKeeperLib.CreateTestKeepers(t, 0.7, []int64{3, 3})
 
 	query := abci.RequestQuery{
 		Path: "",
 		Data: []byte{},
 	}
 
-	querier := NewQuerier(keeper, cdc, types.DefaultCodespace)
+	querier := NewQuerier(bridgeKeeper, keeper, cdc, types.DefaultCodespace)
 
 	//Test wrong paths
 	bz, err := querier(ctx, []string{"other"}, query)
@@ -45,7 +48,7 @@ func TestQueryEthProphecy(t *testing.T) {
 
 	testResponse := types.CreateTestQueryEthProphecyResponse(cdc, t, accAddress)
 
-	bz, err2 := cdc.MarshalJSON(types.NewQueryEthProphecyParams(types.TestNonce, types.TestEthereumAddress))
+	bz, err2 := cdc.MarshalJSON(types.NewQueryEthProphecyParams(initialEthBridgeClaim.ProphecyID()))
 	require.Nil(t, err2)
 
 	query := abci.RequestQuery{
@@ -70,7 +73,7 @@ func TestQueryEthProphecy(t *testing.T) {
 
 	// Test error with nonexistent request
 	query.Data = bz[:len(bz)-1]
-	bz2, err6 := cdc.MarshalJSON(types.NewQueryEthProphecyParams(12, "badEthereumAddress"))
+	bz2, err6 := cdc.MarshalJSON(types.NewQueryEthProphecyParams(types.NewProphecyID(types.TestEthereumChainID, types.TestBridgeContract, types.AltTestEthereumItemID)))
 	require.Nil(t, err6)
 
 	query2 := abci.RequestQuery{
@@ -80,4 +83,275 @@ func TestQueryEthProphecy(t *testing.T) {
 
 	_, err7 := queryEthProphecy(ctx, cdc, query2, keeper, types.DefaultCodespace)
 	require.NotNil(t, err7)
+	require.Equal(t, oracletypes.DefaultCodespace, err7.Codespace())
+	require.Equal(t, oracletypes.CodeProphecyNotFound, err7.Code())
+
+	// Test error with the same lock on another ethereum chain
+	bz3, err8 := cdc.MarshalJSON(types.NewQueryEthProphecyParams(types.NewProphecyID(types.TestEthereumChainID+1, types.TestBridgeContract, types.TestEthereumItemID)))
+	require.Nil(t, err8)
+
+	query3 := abci.RequestQuery{
+		Path: "/custom/ethbridge/prophecies",
+		Data: bz3,
+	}
+
+	_, err9 := queryEthProphecy(ctx, cdc, query3, keeper, types.DefaultCodespace)
+	require.NotNil(t, err9)
+
+	// Test error with the same item id on another bridge contract
+	bz4, err10 := cdc.MarshalJSON(types.NewQueryEthProphecyParams(types.NewProphecyID(types.TestEthereumChainID, types.TestEthereumAddress, types.TestEthereumItemID)))
+	require.Nil(t, err10)
+
+	query3.Data = bz4
+	_, err11 := queryEthProphecy(ctx, cdc, query3, keeper, types.DefaultCodespace)
+	require.NotNil(t, err11)
+}
+
+func TestQueryLargeNonceProphecy(t *testing.T) {
+	cdc := codec.New()
+	ctx, _, keeper, _, validatorAddresses, _ := keeperLib.CreateTestKeepers(t, 0.7, []int64{3, 7})
+	accAddress := sdk.AccAddress(validatorAddresses[0])
+	largeNonce, err := types.ParseNonce(types.TestLargeNonce)
+	require.Nil(t, err)
+
+	ethBridgeClaim := types.CreateTestEthClaim(t, accAddress, types.TestEthereumAddress, types.TestCoins)
+	ethBridgeClaim.Nonce = largeNonce
+	oracleId, validator, claimText := types.CreateOracleClaimFromEthClaim(cdc, ethBridgeClaim)
+	_, err = keeper.ProcessClaim(ctx, oracleId, validator, claimText)
+	require.Nil(t, err)
+
+	bz, err2 := cdc.MarshalJSON(types.NewQueryEthProphecyParams(ethBridgeClaim.ProphecyID()))
+	require.Nil(t, err2)
+
+	query := abci.RequestQuery{
+		Path: "/custom/ethbridge/prophecies",
+		Data: bz,
+	}
+
+	//Test the nonce is read back in full
+	res, err3 := queryEthProphecy(ctx, cdc, query, keeper, types.DefaultCodespace)
+	require.Nil(t, err3)
+
+	var ethProphecyResp types.QueryEthProphecyResponse
+	err4 := cdc.UnmarshalJSON(res, &ethProphecyResp)
+	require.Nil(t, err4)
+	require.Len(t, ethProphecyResp.EthBridgeClaims, 1)
+	require.Equal(t, types.TestLargeNonce, ethProphecyResp.EthBridgeClaims[0].Nonce.String())
+}
+
+func TestQueryNonceProphecies(t *testing.T) {
+	cdc := codec.New()
+	ctx, bridgeKeeper, keeper, _, validatorAddresses := bridgeKeeperLib.CreateTestKeepers(t, 0.7, []int64{3, 7})
+	largeNonce, err := types.ParseNonce(types.TestLargeNonce)
+	require.Nil(t, err)
+
+	//Two validators claim the same nonce for different items
+	claim := types.CreateTestEthClaim(t, sdk.AccAddress(validatorAddresses[0]), types.TestEthereumAddress, types.TestCoins)
+	claim.Nonce = largeNonce
+	conflictingClaim := types.CreateTestEthClaim(t, sdk.AccAddress(validatorAddresses[1]), types.TestEthereumAddress, types.TestCoins)
+	conflictingClaim.Nonce = largeNonce
+	conflictingClaim.ItemID = types.AltTestEthereumItemID
+	for _, ethBridgeClaim := range []types.EthBridgeClaim{claim, conflictingClaim} {
+		oracleId, validator, claimText := types.CreateOracleClaimFromEthClaim(cdc, ethBridgeClaim)
+		_, err = keeper.ProcessClaim(ctx, oracleId, validator, claimText)
+		require.Nil(t, err)
+		bridgeKeeper.SetNonceProphecy(ctx, types.NewNonceProphecy(ethBridgeClaim.Nonce, ethBridgeClaim.ProphecyID(), oracleId))
+	}
+
+	bz, err2 := cdc.MarshalJSON(types.NewQueryNoncePropheciesParams(types.TestEthereumChainID, types.TestBridgeContract, largeNonce))
+	require.Nil(t, err2)
+
+	query := abci.RequestQuery{
+		Path: "/custom/ethbridge/nonce-prophecies",
+		Data: bz,
+	}
+
+	//Test both prophecies are returned, ordered by item id
+	res, err3 := queryNonceProphecies(ctx, cdc, query, bridgeKeeper, keeper)
+	require.Nil(t, err3)
+
+	var prophecies []types.QueryEthProphecyResponse
+	err4 := cdc.UnmarshalJSON(res, &prophecies)
+	require.Nil(t, err4)
+	require.Len(t, prophecies, 2)
+	require.Equal(t, conflictingClaim.ProphecyID(), prophecies[0].ID)
+	require.Equal(t, claim.ProphecyID(), prophecies[1].ID)
+	require.Equal(t, oracletypes.SuccessStatusText, prophecies[0].Status.StatusText)
+	require.Equal(t, oracletypes.PendingStatusText, prophecies[1].Status.StatusText)
+	require.Equal(t, types.TestLargeNonce, prophecies[1].EthBridgeClaims[0].Nonce.String())
+
+	//Test a nonce which would have overflowed to the same int64 has no prophecies
+	bz, err2 = cdc.MarshalJSON(types.NewQueryNoncePropheciesParams(types.TestEthereumChainID, types.TestBridgeContract, sdk.NewUint(types.NonceBigInt(largeNonce).Uint64())))
+	require.Nil(t, err2)
+
+	query.Data = bz
+	res, err3 = queryNonceProphecies(ctx, cdc, query, bridgeKeeper, keeper)
+	require.Nil(t, err3)
+
+	var noProphecies []types.QueryEthProphecyResponse
+	err4 = cdc.UnmarshalJSON(res, &noProphecies)
+	require.Nil(t, err4)
+	require.Empty(t, noProphecies)
+
+	// Test error with bad request
+	query.Data = bz[:len(bz)-1]
+	_, err3 = queryNonceProphecies(ctx, cdc, query, bridgeKeeper, keeper)
+	require.NotNil(t, err3)
+}
+
+func TestQueryBridgeStatus(t *testing.T) {
+	cdc := codec.New()
+	ctx, bridgeKeeper, _, _, _ := bridgeKeeperLib.CreateTestKeepers(t, 0.7, []int64{3, 7})
+
+	bz, err := cdc.MarshalJSON(types.NewQueryBridgeStatusParams(types.TestEthereumChainID))
+	require.Nil(t, err)
+
+	query := abci.RequestQuery{
+		Path: "/custom/ethbridge/status",
+		Data: bz,
+	}
+
+	//Test query before any status is attested
+	res, err2 := queryBridgeStatus(ctx, cdc, query, bridgeKeeper)
+	require.Nil(t, err2)
+
+	var status types.BridgeStatus
+	err3 := cdc.UnmarshalJSON(res, &status)
+	require.Nil(t, err3)
+	require.Equal(t, types.DefaultBridgeStatus(types.TestEthereumChainID), status)
+
+	//Test query after a pause is attested
+	paused := types.NewBridgeStatus(types.TestEthereumChainID, false, 100)
+	bridgeKeeper.SetBridgeStatus(ctx, paused)
+	res, err2 = queryBridgeStatus(ctx, cdc, query, bridgeKeeper)
+	require.Nil(t, err2)
+
+	err3 = cdc.UnmarshalJSON(res, &status)
+	require.Nil(t, err3)
+	require.Equal(t, paused, status)
+
+	// Test error with bad request
+	query.Data = bz[:len(bz)-1]
+	_, err2 = queryBridgeStatus(ctx, cdc, query, bridgeKeeper)
+	require.NotNil(t, err2)
+}
+
+func TestQueryPendingProphecies(t *testing.T) {
+	cdc := codec.New()
+	ctx, _, keeper, _, validatorAddresses, _ := keeperLib.CreateTestKeepers(t, 0.7, []int64{3, 7})
+	accAddress := sdk.AccAddress(validatorAddresses[0])
+
+	//A pending lock, a lock on another chain, a revoked lock and a bridge status prophecy
+	pendingClaim := types.CreateTestEthClaim(t, accAddress, types.TestEthereumAddress, types.TestCoins)
+	otherChainClaim := pendingClaim
+	otherChainClaim.EthereumChainID = types.TestEthereumChainID + 1
+	revokedClaim := types.CreateTestEthClaim(t, accAddress, types.AltTestEthereumAddress, types.TestCoins)
+	revokedClaim.ItemID = types.AltTestEthereumItemID
+	revokedClaim.Nonce = sdk.NewUint(types.TestNonce + 1)
+	for _, claim := range []types.EthBridgeClaim{pendingClaim, otherChainClaim, revokedClaim} {
+		oracleId, validator, claimText := types.CreateOracleClaimFromEthClaim(cdc, claim)
+		_, err := keeper.ProcessClaim(ctx, oracleId, validator, claimText)
+		require.Nil(t, err)
+	}
+	_, err := keeper.RevokeProphecy(ctx, string(revokedClaim.ProphecyID().Key()))
+	require.Nil(t, err)
+	statusClaim := types.NewBridgeStatusClaim(types.TestEthereumChainID, false, 100, accAddress)
+	oracleId, validator, claimText := types.CreateOracleClaimFromBridgeStatusClaim(cdc, statusClaim)
+	_, err = keeper.ProcessClaim(ctx, oracleId, validator, claimText)
+	require.Nil(t, err)
+
+	bz, err2 := cdc.MarshalJSON(types.NewQueryPendingPropheciesParams(types.TestEthereumChainID))
+	require.Nil(t, err2)
+
+	query := abci.RequestQuery{
+		Path: "/custom/ethbridge/pending-prophecies",
+		Data: bz,
+	}
+
+	//Test query
+	res, err3 := queryPendingProphecies(ctx, cdc, query, keeper)
+	require.Nil(t, err3)
+
+	var prophecies []types.QueryEthProphecyResponse
+	err4 := cdc.UnmarshalJSON(res, &prophecies)
+	require.Nil(t, err4)
+	require.Equal(t, []types.QueryEthProphecyResponse{types.CreateTestQueryEthProphecyResponse(cdc, t, accAddress)}, prophecies)
+	require.Equal(t, types.CreateTestEthereumProvenance(), prophecies[0].EthBridgeClaims[0].EthereumProvenance)
+
+	// Test error with bad request
+	query.Data = bz[:len(bz)-1]
+	_, err3 = queryPendingProphecies(ctx, cdc, query, keeper)
+	require.NotNil(t, err3)
+}
+
+func TestQueryNonceGaps(t *testing.T) {
+	cdc := codec.New()
+	ctx, bridgeKeeper, keeper, _, validatorAddresses := bridgeKeeperLib.CreateTestKeepers(t, 0.7, []int64{3, 7})
+
+	//Nonce 1 is pending, 3 has succeeded with its mint queued and 5 is pending
+	var prophecyIDs []types.ProphecyID
+	for i, nonce := range []uint64{1, 3, 5} {
+		validator := validatorAddresses[0]
+		if nonce == 3 {
+			validator = validatorAddresses[1]
+		}
+		claim := types.CreateTestEthClaim(t, sdk.AccAddress(validator), types.TestEthereumAddress, types.TestCoins)
+		claim.Nonce = sdk.NewUint(nonce)
+		claim.ItemID = []string{types.TestEthereumItemID, types.AltTestEthereumItemID, "0x" + strings.Repeat("ab", 32)}[i]
+		oracleId, oracleValidator, claimText := types.CreateOracleClaimFromEthClaim(cdc, claim)
+		_, err := keeper.ProcessClaim(ctx, oracleId, oracleValidator, claimText)
+		require.Nil(t, err)
+		nonceProphecy := types.NewNonceProphecy(claim.Nonce, claim.ProphecyID(), oracleId)
+		bridgeKeeper.SetNonceProphecy(ctx, nonceProphecy)
+		if nonce == 3 {
+			bridgeKeeper.SetQueuedMint(ctx, nonceProphecy)
+		}
+		prophecyIDs = append(prophecyIDs, claim.ProphecyID())
+	}
+
+	bz, err2 := cdc.MarshalJSON(types.NewQueryNonceGapsParams(types.TestEthereumChainID, types.TestBridgeContract, 0))
+	require.Nil(t, err2)
+
+	query := abci.RequestQuery{
+		Path: "/custom/ethbridge/nonce-gaps",
+		Data: bz,
+	}
+
+	//Test every nonce up to the highest claimed one which is not finalized is listed
+	res, err3 := queryNonceGaps(ctx, cdc, query, bridgeKeeper, keeper)
+	require.Nil(t, err3)
+
+	var response types.QueryNonceGapsResponse
+	err4 := cdc.UnmarshalJSON(res, &response)
+	require.Nil(t, err4)
+	require.True(t, response.LastFinalizedNonce.IsZero())
+	require.Equal(t, sdk.NewUint(5), response.HighestClaimedNonce)
+	require.Equal(t, []types.NonceGap{
+		types.NewNonceGap(sdk.NewUint(1), types.NonceGapPending, prophecyIDs[:1]),
+		types.NewNonceGap(sdk.NewUint(2), types.NonceGapMissing, nil),
+		types.NewNonceGap(sdk.NewUint(4), types.NonceGapMissing, nil),
+		types.NewNonceGap(sdk.NewUint(5), types.NonceGapPending, prophecyIDs[2:]),
+	}, response.Gaps)
+	require.Equal(t, []sdk.Uint{sdk.NewUint(3)}, response.QueuedNonces)
+
+	//Test the gaps are limited, and start after the last finalized nonce
+	bridgeKeeper.SetLastFinalizedNonce(ctx, types.TestEthereumChainID, types.TestBridgeContract, sdk.NewUint(1))
+	bz, err2 = cdc.MarshalJSON(types.NewQueryNonceGapsParams(types.TestEthereumChainID, types.TestBridgeContract, 1))
+	require.Nil(t, err2)
+
+	query.Data = bz
+	res, err3 = queryNonceGaps(ctx, cdc, query, bridgeKeeper, keeper)
+	require.Nil(t, err3)
+
+	var limited types.QueryNonceGapsResponse
+	err4 = cdc.UnmarshalJSON(res, &limited)
+	require.Nil(t, err4)
+	require.Equal(t, sdk.NewUint(1), limited.LastFinalizedNonce)
+	require.Equal(t, sdk.NewUint(5), limited.HighestClaimedNonce)
+	require.Equal(t, []types.NonceGap{types.NewNonceGap(sdk.NewUint(2), types.NonceGapMissing, nil)}, limited.Gaps)
+
+	// Test error with bad request
+	query.Data = bz[:len(bz)-1]
+	_, err3 = queryNonceGaps(ctx, cdc, query, bridgeKeeper, keeper)
+	require.NotNil(t, err3)
 }
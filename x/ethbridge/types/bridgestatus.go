@@ -0,0 +1,85 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// bridgeStatusPrefix is prepended to the ethereum block number to form the id of a bridge status prophecy
+const bridgeStatusPrefix = "bridgestatus"
+
+// BridgeStatus is whether locking on the bridge contract of an ethereum chain is active, as of the given ethereum block
+type BridgeStatus struct {
+	EthereumChainID int    `json:"ethereum_chain_id"`
+	Active          bool   `json:"active"`
+	EthereumBlock   uint64 `json:"ethereum_block"`
+}
+
+// NewBridgeStatus is a constructor function for BridgeStatus
+func NewBridgeStatus(ethereumChainID int, active bool, ethereumBlock uint64) BridgeStatus {
+	return BridgeStatus{
+		EthereumChainID: ethereumChainID,
+		Active:          active,
+		EthereumBlock:   ethereumBlock,
+	}
+}
+
+// DefaultBridgeStatus is the status of a newly deployed bridge contract, which starts active
+func DefaultBridgeStatus(ethereumChainID int) BridgeStatus {
+	return NewBridgeStatus(ethereumChainID, true, 0)
+}
+
+func (status BridgeStatus) String() string {
+	if status.Active {
+		return fmt.Sprintf("active on chain %d as of ethereum block %d", status.EthereumChainID, status.EthereumBlock)
+	}
+	return fmt.Sprintf("paused on chain %d as of ethereum block %d", status.EthereumChainID, status.EthereumBlock)
+}
+
+// BridgeStatusClaim is a validator's claim that locking on the bridge contract was paused or activated
+type BridgeStatusClaim struct {
+	EthereumChainID int            `json:"ethereum_chain_id"`
+	Active          bool           `json:"active"`
+	EthereumBlock   uint64         `json:"ethereum_block"`
+	Validator       sdk.AccAddress `json:"validator"`
+}
+
+// NewBridgeStatusClaim is a constructor function for BridgeStatusClaim
+func NewBridgeStatusClaim(ethereumChainID int, active bool, ethereumBlock uint64, validator sdk.AccAddress) BridgeStatusClaim {
+	return BridgeStatusClaim{
+		EthereumChainID: ethereumChainID,
+		Active:          active,
+		EthereumBlock:   ethereumBlock,
+		Validator:       validator,
+	}
+}
+
+// CreateBridgeStatusProphecyID returns the id of the prophecy on the bridge status of an ethereum chain at the given block
+func CreateBridgeStatusProphecyID(ethereumChainID int, ethereumBlock uint64) string {
+	return bridgeStatusPrefix + strconv.Itoa(ethereumChainID) + ":" + strconv.FormatUint(ethereumBlock, 10)
+}
+
+// CreateOracleClaimFromBridgeStatusClaim returns the oracle id, validator and claim content of a bridge status claim
+func CreateOracleClaimFromBridgeStatusClaim(cdc *codec.Codec, statusClaim BridgeStatusClaim) (string, sdk.ValAddress, string) {
+	oracleId := CreateBridgeStatusProphecyID(statusClaim.EthereumChainID, statusClaim.EthereumBlock)
+	claimContent := NewBridgeStatus(statusClaim.EthereumChainID, statusClaim.Active, statusClaim.EthereumBlock)
+	claimBytes, _ := json.Marshal(claimContent)
+	validator := sdk.ValAddress(statusClaim.Validator)
+	return oracleId, validator, string(claimBytes)
+}
+
+// CreateBridgeStatusFromOracleString parses the final claim of a bridge status prophecy
+func CreateBridgeStatusFromOracleString(oracleClaimString string) (BridgeStatus, sdk.Error) {
+	var status BridgeStatus
+
+	errRes := json.Unmarshal([]byte(oracleClaimString), &status)
+	if errRes != nil {
+		return BridgeStatus{}, sdk.ErrInternal(fmt.Sprintf("failed to parse bridge status: %s", errRes))
+	}
+
+	return status, nil
+}
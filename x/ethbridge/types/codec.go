@@ -7,4 +7,11 @@ import (
 // RegisterCodec registers concrete types on the Amino codec
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgMakeEthBridgeClaim{}, "oracle/MsgMakeEthBridgeClaim", nil)
+	cdc.RegisterConcrete(MsgRevokeEthBridgeClaim{}, "ethbridge/MsgRevokeEthBridgeClaim", nil)
+	cdc.RegisterConcrete(MsgMakeBridgeStatusClaim{}, "ethbridge/MsgMakeBridgeStatusClaim", nil)
+	cdc.RegisterConcrete(MsgSetBridgeStatus{}, "ethbridge/MsgSetBridgeStatus", nil)
+	cdc.RegisterConcrete(MsgWithdrawRelayerRewards{}, "ethbridge/MsgWithdrawRelayerRewards", nil)
+	cdc.RegisterConcrete(MsgSetMintLimits{}, "ethbridge/MsgSetMintLimits", nil)
+	cdc.RegisterConcrete(MsgDisputeEscrowedMint{}, "ethbridge/MsgDisputeEscrowedMint", nil)
+	cdc.RegisterConcrete(MsgMakeInvalidReceiverClaim{}, "ethbridge/MsgMakeInvalidReceiverClaim", nil)
 }
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -11,14 +13,79 @@ type CodeType = sdk.CodeType
 const (
 	DefaultCodespace sdk.CodespaceType = "ethbridge"
 
-	CodeInvalidEthNonce   CodeType = 1
-	CodeInvalidEthAddress CodeType = 2
+	CodeInvalidEthNonce      CodeType = 1
+	CodeInvalidEthAddress    CodeType = 2
+	CodeInvalidRevocation    CodeType = 3
+	CodeInvalidEthBlock      CodeType = 4
+	CodeInvalidChainID       CodeType = 5
+	CodeInvalidEthTxHash     CodeType = 6
+	CodeInvalidEthProvenance CodeType = 7
+	CodeInvalidEthItemID     CodeType = 8
+	CodeNonceAlreadyMinted   CodeType = 9
+	CodeUnauthorizedAdmin    CodeType = 10
+	CodeNoRelayerRewards     CodeType = 11
+	CodeInvalidMintLimits    CodeType = 12
+	CodeEscrowNotFound       CodeType = 13
+	CodeInvalidDispute       CodeType = 14
+	CodeInvalidReceiverClaim CodeType = 15
 )
 
 func ErrInvalidEthNonce(codespace sdk.CodespaceType) sdk.Error {
-	return sdk.NewError(codespace, CodeInvalidEthNonce, "invalid ethereum nonce provided, must be >= 0")
+	return sdk.NewError(codespace, CodeInvalidEthNonce, "invalid ethereum nonce provided, must be an unsigned integer of at most 256 bits")
 }
 
 func ErrInvalidEthAddress(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidEthAddress, "invalid ethereum address provided, must be a valid hex-encoded Ethereum address")
 }
+
+func ErrInvalidEthBlock(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidEthBlock, "invalid ethereum block number provided, must be > 0")
+}
+
+func ErrInvalidChainID(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidChainID, "invalid ethereum chain id provided, must be > 0")
+}
+
+func ErrInvalidEthTxHash(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidEthTxHash, "invalid ethereum transaction hash provided, must be a 0x-prefixed 32 byte hex string")
+}
+
+func ErrInvalidEthProvenance(codespace sdk.CodespaceType, field string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidEthProvenance, fmt.Sprintf("invalid ethereum %s provided, must be a 0x-prefixed 32 byte hex string", field))
+}
+
+func ErrInvalidEthItemID(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidEthItemID, "invalid ethereum item id provided, must be a 0x-prefixed 32 byte hex string")
+}
+
+func ErrNonceAlreadyMinted(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeNonceAlreadyMinted, "the nonce of the bridge contract was already minted by another prophecy")
+}
+
+func ErrUnauthorizedAdmin(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeUnauthorizedAdmin, "only the bridge admin set in the ethbridge params can set the bridge status or mint limits")
+}
+
+func ErrNoRelayerRewards(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeNoRelayerRewards, "the validator has no relayer rewards to withdraw")
+}
+
+func ErrInvalidMintLimits(codespace sdk.CodespaceType, reason string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidMintLimits, fmt.Sprintf("invalid mint limits provided: %s", reason))
+}
+
+func ErrEscrowNotFound(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeEscrowNotFound, "no mint is held in escrow on the nonce of the bridge contract")
+}
+
+func ErrInvalidDispute(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidDispute, "escrowed mints can only be disputed once by each bonded validator")
+}
+
+func ErrInvalidReceiverClaim(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidReceiverClaim, "claims on locks to invalid receivers must give the lock's recipient and no cosmos receiver")
+}
+
+func ErrInvalidRevocation(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidRevocation, "invalid revocation reason provided, must be withdraw or unlock")
+}
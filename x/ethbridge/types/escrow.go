@@ -0,0 +1,46 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ExceedsEscrowThreshold returns true if the amount of a denom is above its escrow threshold. Denoms the threshold
+// does not list are never escrowed.
+func ExceedsEscrowThreshold(amount sdk.Coins, threshold sdk.Coins) bool {
+	for _, coin := range amount {
+		min := threshold.AmountOf(coin.Denom)
+		if min.IsPositive() && coin.Amount.GT(min) {
+			return true
+		}
+	}
+	return false
+}
+
+// EscrowedMint is the mint of a successful prophecy above the escrow threshold, held until its release height so
+// that validators can dispute it. It is cancelled if the validators disputing it hold enough power for consensus.
+type EscrowedMint struct {
+	NonceProphecy NonceProphecy `json:"nonce_prophecy"`
+	// ReleaseHeight is the block height at the end of which the mint is released
+	ReleaseHeight int64 `json:"release_height"`
+	// Disputes are the validators which have disputed the mint
+	Disputes []sdk.ValAddress `json:"disputes"`
+}
+
+// NewEscrowedMint is a constructor function for EscrowedMint
+func NewEscrowedMint(nonceProphecy NonceProphecy, releaseHeight int64) EscrowedMint {
+	return EscrowedMint{
+		NonceProphecy: nonceProphecy,
+		ReleaseHeight: releaseHeight,
+		Disputes:      []sdk.ValAddress{},
+	}
+}
+
+// HasDisputed returns true if a validator has already disputed the mint
+func (mint EscrowedMint) HasDisputed(validator sdk.ValAddress) bool {
+	for _, dispute := range mint.Disputes {
+		if dispute.Equals(validator) {
+			return true
+		}
+	}
+	return false
+}
@@ -3,68 +3,135 @@ package types
 import (
 	"encoding/json"
 	"fmt"
-	"strconv"
+	"math/big"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 type EthBridgeClaim struct {
-	Nonce          int            `json:"nonce"`
-	EthereumSender string         `json:"ethereum_sender"`
-	CosmosReceiver sdk.AccAddress `json:"cosmos_receiver"`
-	Validator      sdk.AccAddress `json:"validator"`
-	Amount         sdk.Coins      `json:"amount"`
+	EthereumChainID       int            `json:"ethereum_chain_id"`
+	BridgeContractAddress string         `json:"bridge_contract_address"`
+	ItemID                string         `json:"item_id"`
+	Nonce                 sdk.Uint       `json:"nonce"`
+	EthereumSender        string         `json:"ethereum_sender"`
+	CosmosReceiver        sdk.AccAddress `json:"cosmos_receiver"`
+	Validator             sdk.AccAddress `json:"validator"`
+	Amount                sdk.Coins      `json:"amount"`
+	// EthereumProvenance is the ethereum log which made the lock, so that the claim can be verified against
+	// ethereum independently of the relayer which made it
+	EthereumProvenance EthereumProvenance `json:"ethereum_provenance"`
+	// InvalidReceiver is set instead of CosmosReceiver by claims that the lock's recipient is not a valid cosmos
+	// address. It is the recipient the lock was made to, hex encoded.
+	InvalidReceiver string `json:"invalid_receiver,omitempty"`
 }
 
 // NewEthBridgeClaim is a constructor function for NewEthBridgeClaim
-func NewEthBridgeClaim(nonce int, ethereumSender string, cosmosReceiver sdk.AccAddress, validator sdk.AccAddress, amount sdk.Coins) EthBridgeClaim {
+func NewEthBridgeClaim(ethereumChainID int, bridgeContractAddress string, itemID string, nonce sdk.Uint, ethereumSender string, cosmosReceiver sdk.AccAddress, validator sdk.AccAddress, amount sdk.Coins, ethereumProvenance EthereumProvenance) EthBridgeClaim {
 	return EthBridgeClaim{
-		Nonce:          nonce,
-		EthereumSender: ethereumSender,
-		CosmosReceiver: cosmosReceiver,
-		Validator:      validator,
-		Amount:         amount,
+		EthereumChainID:       ethereumChainID,
+		BridgeContractAddress: bridgeContractAddress,
+		ItemID:                itemID,
+		Nonce:                 nonce,
+		EthereumSender:        ethereumSender,
+		CosmosReceiver:        cosmosReceiver,
+		Validator:             validator,
+		Amount:                amount,
+		EthereumProvenance:    ethereumProvenance,
 	}
 }
 
+// NewInvalidReceiverClaim is a constructor function for an EthBridgeClaim that a lock was made to a recipient which
+// is not a valid cosmos address
+func NewInvalidReceiverClaim(ethereumChainID int, bridgeContractAddress string, itemID string, nonce sdk.Uint, ethereumSender string, invalidReceiver string, validator sdk.AccAddress, amount sdk.Coins, ethereumProvenance EthereumProvenance) EthBridgeClaim {
+	claim := NewEthBridgeClaim(ethereumChainID, bridgeContractAddress, itemID, nonce, ethereumSender, nil, validator, amount, ethereumProvenance)
+	claim.InvalidReceiver = invalidReceiver
+	return claim
+}
+
+// ProphecyID returns the id of the prophecy on the claimed lock
+func (claim EthBridgeClaim) ProphecyID() ProphecyID {
+	return NewProphecyID(claim.EthereumChainID, claim.BridgeContractAddress, claim.ItemID)
+}
+
 //OracleClaim is the details of how the claim for each validator will be stored in the oracle
 type OracleClaim struct {
+	// EthereumSender and Nonce are part of the claim since the prophecy id does not carry them
+	EthereumSender string         `json:"ethereum_sender"`
+	Nonce          sdk.Uint       `json:"nonce"`
 	CosmosReceiver sdk.AccAddress `json:"cosmos_receiver"`
 	Amount         sdk.Coins      `json:"amount"`
+	// EthereumProvenance is part of the claim so that claims on different ethereum logs are distinct
+	EthereumProvenance EthereumProvenance `json:"ethereum_provenance"`
+	// InvalidReceiver is the recipient of a lock which is not a valid cosmos address. Such a claim mints nothing,
+	// and makes the lock refundable on ethereum if its prophecy succeeds.
+	InvalidReceiver string `json:"invalid_receiver,omitempty"`
 }
 
 // NewOracleClaim is a constructor function for OracleClaim
-func NewOracleClaim(cosmosReceiver sdk.AccAddress, amount sdk.Coins) OracleClaim {
+func NewOracleClaim(ethereumSender string, nonce sdk.Uint, cosmosReceiver sdk.AccAddress, amount sdk.Coins, ethereumProvenance EthereumProvenance) OracleClaim {
 	return OracleClaim{
-		CosmosReceiver: cosmosReceiver,
-		Amount:         amount,
+		EthereumSender:     ethereumSender,
+		Nonce:              nonce,
+		CosmosReceiver:     cosmosReceiver,
+		Amount:             amount,
+		EthereumProvenance: ethereumProvenance,
 	}
 }
 
+// IsInvalidReceiver returns true if the claim is that the lock's recipient is not a valid cosmos address
+func (claim OracleClaim) IsInvalidReceiver() bool {
+	return claim.InvalidReceiver != ""
+}
+
+// ParseNonce parses a decimal ethereum nonce. Nonces are uint256 on ethereum, so they may not fit in an int64.
+func ParseNonce(nonce string) (sdk.Uint, sdk.Error) {
+	i, ok := new(big.Int).SetString(nonce, 10)
+	if !ok || i.Sign() < 0 || i.BitLen() > 256 {
+		return sdk.Uint{}, ErrInvalidEthNonce(DefaultCodespace)
+	}
+	return sdk.NewUintFromBigInt(i), nil
+}
+
+// IsValidNonce returns true if a nonce was given and is one the bridge contract can give a lock. The contract's
+// nonces start at 1, and a nonce missing from a msg decodes to a nil sdk.Uint, which panics when used.
+func IsValidNonce(nonce sdk.Uint) bool {
+	return nonce != (sdk.Uint{}) && !nonce.IsZero()
+}
+
+// CreateOracleClaimFromEthClaim returns the oracle id of the claim's prophecy, the validator making the claim and
+// the claim content
 func CreateOracleClaimFromEthClaim(cdc *codec.Codec, ethClaim EthBridgeClaim) (string, sdk.ValAddress, string) {
-	oracleId := strconv.Itoa(ethClaim.Nonce) + ethClaim.EthereumSender
-	claimContent := NewOracleClaim(ethClaim.CosmosReceiver, ethClaim.Amount)
+	oracleId := string(ethClaim.ProphecyID().Key())
+	claimContent := NewOracleClaim(ethClaim.EthereumSender, ethClaim.Nonce, ethClaim.CosmosReceiver, ethClaim.Amount, ethClaim.EthereumProvenance)
+	claimContent.InvalidReceiver = ethClaim.InvalidReceiver
 	claimBytes, _ := json.Marshal(claimContent)
 	claim := string(claimBytes)
 	validator := sdk.ValAddress(ethClaim.Validator)
 	return oracleId, validator, claim
 }
 
-func CreateEthClaimFromOracleString(nonce int, ethereumSender string, validator sdk.ValAddress, oracleClaimString string) (EthBridgeClaim, sdk.Error) {
+// CreateEthClaimFromOracleString returns the claim a validator made on the prophecy with the given id
+func CreateEthClaimFromOracleString(prophecyID ProphecyID, validator sdk.ValAddress, oracleClaimString string) (EthBridgeClaim, sdk.Error) {
 	oracleClaim, err := CreateOracleClaimFromOracleString(oracleClaimString)
 	if err != nil {
 		return EthBridgeClaim{}, err
 	}
 
 	valAccAddress := sdk.AccAddress(validator)
-	return NewEthBridgeClaim(
-		nonce,
-		ethereumSender,
+	ethClaim := NewEthBridgeClaim(
+		prophecyID.EthereumChainID,
+		prophecyID.BridgeContractAddress,
+		prophecyID.ItemID,
+		oracleClaim.Nonce,
+		oracleClaim.EthereumSender,
 		oracleClaim.CosmosReceiver,
 		valAccAddress,
 		oracleClaim.Amount,
-	), nil
+		oracleClaim.EthereumProvenance,
+	)
+	ethClaim.InvalidReceiver = oracleClaim.InvalidReceiver
+	return ethClaim, nil
 }
 
 func CreateOracleClaimFromOracleString(oracleClaimString string) (OracleClaim, sdk.Error) {
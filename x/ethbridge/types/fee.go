@@ -0,0 +1,87 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BasisPointsPerUnit is the number of basis points in the whole of an amount
+const BasisPointsPerUnit = 10000
+
+// BridgeFee returns the fee deducted from the minted amount of a lock: for each denom, the flat fee of that denom
+// plus the given basis points of the amount, capped at the amount
+func BridgeFee(minted sdk.Coins, flat sdk.Coins, basisPoints uint64) sdk.Coins {
+	fee := sdk.Coins{}
+	for _, coin := range minted {
+		amount := flat.AmountOf(coin.Denom).Add(coin.Amount.MulRaw(int64(basisPoints)).QuoRaw(BasisPointsPerUnit))
+		if coin.Amount.LT(amount) {
+			amount = coin.Amount
+		}
+		if amount.IsPositive() {
+			fee = append(fee, sdk.NewCoin(coin.Denom, amount))
+		}
+	}
+	return fee.Sort()
+}
+
+// SplitByPower splits coins between validators in proportion to their powers, rounding each share down. It returns
+// the share of each validator and the remainder left by rounding, which is all of the coins if no validator has
+// power.
+func SplitByPower(coins sdk.Coins, powers []int64) ([]sdk.Coins, sdk.Coins) {
+	totalPower := int64(0)
+	for _, power := range powers {
+		totalPower += power
+	}
+	shares := make([]sdk.Coins, len(powers))
+	if totalPower <= 0 {
+		return shares, coins
+	}
+	remainder := coins
+	for i, power := range powers {
+		share := sdk.Coins{}
+		for _, coin := range coins {
+			amount := coin.Amount.MulRaw(power).QuoRaw(totalPower)
+			if amount.IsPositive() {
+				share = append(share, sdk.NewCoin(coin.Denom, amount))
+			}
+		}
+		shares[i] = share
+		remainder = remainder.Sub(share)
+	}
+	return shares, remainder
+}
+
+// RelayerReward is the part of the bridge fees a validator has earned by relaying claims and not yet withdrawn
+type RelayerReward struct {
+	Validator sdk.AccAddress `json:"validator"`
+	Rewards   sdk.Coins      `json:"rewards"`
+}
+
+// NewRelayerReward is a constructor function for RelayerReward
+func NewRelayerReward(validator sdk.AccAddress, rewards sdk.Coins) RelayerReward {
+	return RelayerReward{
+		Validator: validator,
+		Rewards:   rewards,
+	}
+}
+
+func (reward RelayerReward) String() string {
+	return fmt.Sprintf("%s: %s", reward.Validator, reward.Rewards)
+}
+
+// MintFee is the bridge fee deducted from the mint of a lock prophecy, which a revocation of the lock does not claw
+// back from the receiver
+type MintFee struct {
+	// OracleID is the id the prophecy is stored under in the oracle
+	OracleID string    `json:"oracle_id"`
+	Fee      sdk.Coins `json:"fee"`
+}
+
+// NewMintFee is a constructor function for MintFee
+func NewMintFee(oracleID string, fee sdk.Coins) MintFee {
+	return MintFee{
+		OracleID: oracleID,
+		Fee:      fee,
+	}
+}
@@ -0,0 +1,46 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBridgeFee(t *testing.T) {
+	minted := sdk.Coins{sdk.NewInt64Coin("ethereum", 2000), sdk.NewInt64Coin("token", 5)}
+
+	//No fee is charged by default
+	require.True(t, BridgeFee(minted, nil, 0).IsZero())
+
+	//The flat fee of a denom is added to the basis points of its amount
+	fee := BridgeFee(minted, sdk.Coins{sdk.NewInt64Coin("ethereum", 3)}, 25)
+	require.Equal(t, "8ethereum", fee.String())
+
+	//The fee never exceeds the minted amount
+	fee = BridgeFee(minted, sdk.Coins{sdk.NewInt64Coin("token", 10)}, 0)
+	require.Equal(t, "5token", fee.String())
+	fee = BridgeFee(minted, nil, 2*BasisPointsPerUnit)
+	require.True(t, fee.IsEqual(minted))
+}
+
+func TestSplitByPower(t *testing.T) {
+	coins := sdk.Coins{sdk.NewInt64Coin("ethereum", 10)}
+
+	//Shares follow the validators' powers, and rounding leaves a remainder
+	shares, remainder := SplitByPower(coins, []int64{3, 7})
+	require.Equal(t, "3ethereum", shares[0].String())
+	require.Equal(t, "7ethereum", shares[1].String())
+	require.True(t, remainder.IsZero())
+
+	shares, remainder = SplitByPower(coins, []int64{1, 1, 1})
+	for _, share := range shares {
+		require.Equal(t, "3ethereum", share.String())
+	}
+	require.Equal(t, "1ethereum", remainder.String())
+
+	//Validators without power get nothing
+	shares, remainder = SplitByPower(coins, []int64{0, 0})
+	require.True(t, shares[0].IsZero())
+	require.True(t, remainder.IsEqual(coins))
+}
@@ -0,0 +1,69 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GenesisState is the ethbridge state at genesis
+type GenesisState struct {
+	Params Params `json:"params"`
+	// BridgedSupply is the amount of each denom minted by the bridge and not clawed back
+	BridgedSupply sdk.Coins `json:"bridged_supply"`
+	// Minted is the coins the bridge has minted into its module account
+	Minted sdk.Coins `json:"minted"`
+	// Burned is the coins the bridge has burned from its module account
+	Burned sdk.Coins `json:"burned"`
+	// MintingPaused is whether the bridge admin has paused minting
+	MintingPaused bool `json:"minting_paused"`
+	// PausedMints are the mints held until minting is resumed
+	PausedMints []NonceProphecy `json:"paused_mints"`
+	// QueuedMints are the mints queued until the prophecies on the earlier nonces of their contracts are finalized
+	QueuedMints []NonceProphecy `json:"queued_mints"`
+	// LastFinalizedNonces are the nonces of each bridge contract up to which every prophecy has been finalized
+	LastFinalizedNonces []LastFinalizedNonce `json:"last_finalized_nonces"`
+	// DelayedMints are the mints delayed until they fit in the mint limits
+	DelayedMints []DelayedMint `json:"delayed_mints"`
+	// WindowMints are the coins minted in the current mint limit window
+	WindowMints []WindowMint `json:"window_mints"`
+	// EscrowedMints are the mints held in escrow, with the validators which have disputed them
+	EscrowedMints []EscrowedMint `json:"escrowed_mints"`
+	// Refunds are the refunds of the locks made to invalid cosmos receivers
+	Refunds []Refund `json:"refunds"`
+	// RelayerRewards are the bridge fees validators have earned and not withdrawn
+	RelayerRewards []RelayerReward `json:"relayer_rewards"`
+	// UndistributedFees are the bridge fees left over by rounding
+	UndistributedFees sdk.Coins `json:"undistributed_fees"`
+	// MintFees are the bridge fees deducted from the mints of lock prophecies
+	MintFees []MintFee `json:"mint_fees"`
+}
+
+// NewGenesisState is a constructor function for GenesisState
+func NewGenesisState(params Params, bridgedSupply sdk.Coins, minted sdk.Coins, burned sdk.Coins, mintingPaused bool,
+	pausedMints []NonceProphecy, queuedMints []NonceProphecy, lastFinalizedNonces []LastFinalizedNonce,
+	delayedMints []DelayedMint, windowMints []WindowMint, escrowedMints []EscrowedMint, refunds []Refund,
+	relayerRewards []RelayerReward, undistributedFees sdk.Coins, mintFees []MintFee) GenesisState {
+	return GenesisState{
+		Params:              params,
+		BridgedSupply:       bridgedSupply,
+		Minted:              minted,
+		Burned:              burned,
+		MintingPaused:       mintingPaused,
+		PausedMints:         pausedMints,
+		QueuedMints:         queuedMints,
+		LastFinalizedNonces: lastFinalizedNonces,
+		DelayedMints:        delayedMints,
+		WindowMints:         windowMints,
+		EscrowedMints:       escrowedMints,
+		Refunds:             refunds,
+		RelayerRewards:      relayerRewards,
+		UndistributedFees:   undistributedFees,
+		MintFees:            mintFees,
+	}
+}
+
+// DefaultGenesisState returns the genesis state of a new chain
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState(DefaultParams(), sdk.Coins{}, sdk.Coins{}, sdk.Coins{}, false, []NonceProphecy{},
+		[]NonceProphecy{}, []LastFinalizedNonce{}, []DelayedMint{}, []WindowMint{}, []EscrowedMint{}, []Refund{},
+		[]RelayerReward{}, sdk.Coins{}, []MintFee{})
+}
@@ -1,5 +1,12 @@
 package types
 
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto"
+)
+
 const (
 	// ModuleName is the name of the ethereum bridge module
 	ModuleName = "ethbridge"
@@ -12,4 +19,99 @@ const (
 
 	// RouterKey is the msg router key for the ethereum bridge module
 	RouterKey = ModuleName
+
+	// DefaultParamspace is the params subspace of the ethereum bridge module
+	DefaultParamspace = ModuleName
+
+	// ProphecyIDVersion is the version of the format of lock and revocation prophecy ids. Version 0 ids were text
+	// made of the chain id, nonce and ethereum sender; version 1 ids are the binary keys of ProphecyID, made of the chain id, bridge contract and item id.
+	ProphecyIDVersion uint64 = 1
 )
+
+var (
+	// ModuleAddress is the address of the ethbridge module account, which the bridge mints coins into before
+	// sending them to receivers and burns clawed back coins from. No key controls it.
+	ModuleAddress = sdk.AccAddress(crypto.AddressHash([]byte(ModuleName)))
+
+	// BridgeStatusKeyPrefix prefixes the store keys of the last attested status of each chain's bridge contract
+	BridgeStatusKeyPrefix = []byte("bridgeStatus")
+
+	// ProphecyIDVersionKey is the store key of the version of the prophecy ids stored in the oracle
+	ProphecyIDVersionKey = []byte("prophecyIDVersion")
+
+	// NonceProphecyKeyPrefix prefixes the store keys of the index from the nonces bridge contracts gave locks to
+	// the prophecies claiming them
+	NonceProphecyKeyPrefix = []byte("nonceProphecy")
+
+	// LastFinalizedNonceKeyPrefix prefixes the store keys of the last finalized nonce of each bridge contract
+	LastFinalizedNonceKeyPrefix = []byte("lastFinalizedNonce")
+
+	// QueuedMintKeyPrefix prefixes the store keys of the successful prophecies whose mint waits for the prophecies
+	// on earlier nonces to be finalized
+	QueuedMintKeyPrefix = []byte("queuedMint")
+
+	// BridgedSupplyKeyPrefix prefixes the store keys of the amount of each denom minted by the bridge and not
+	// clawed back
+	BridgedSupplyKeyPrefix = []byte("bridgedSupply:")
+
+	// SupplyTrackedKey is the store key of whether the bridged supply accounts for every coin the bridge has minted
+	SupplyTrackedKey = []byte("supplyTracked")
+
+	// MintedKey is the store key of the coins the bridge has minted into its module account
+	MintedKey = []byte("minted")
+
+	// BurnedKey is the store key of the coins the bridge has burned from its module account
+	BurnedKey = []byte("burned")
+
+	// MintFeeKeyPrefix prefixes the store keys of the bridge fee deducted from the mint of each lock prophecy
+	MintFeeKeyPrefix = []byte("mintFee:")
+
+	// RelayerRewardsKeyPrefix prefixes the store keys of the bridge fees each validator has earned and not withdrawn
+	RelayerRewardsKeyPrefix = []byte("relayerRewards:")
+
+	// UndistributedFeesKey is the store key of the bridge fees left over by rounding, which are added to the next fee
+	UndistributedFeesKey = []byte("undistributedFees")
+
+	// MintingPausedKey is the store key of whether the bridge admin has paused minting
+	MintingPausedKey = []byte("mintingPaused")
+
+	// PausedMintKeyPrefix prefixes the store keys of the successful prophecies whose mint is held while minting
+	// is paused
+	PausedMintKeyPrefix = []byte("pausedMint")
+
+	// DelayedMintKeyPrefix prefixes the store keys of the successful prophecies whose mint is delayed because it
+	// would have gone over a mint limit
+	DelayedMintKeyPrefix = []byte("delayedMint")
+
+	// WindowMintKeyPrefix prefixes the store keys of the coins minted to each receiver at each block height in the
+	// current mint limit window
+	WindowMintKeyPrefix = []byte("windowMint:")
+
+	// EscrowedMintKeyPrefix prefixes the store keys of the successful prophecies whose mint is held in escrow until
+	// the end of its dispute window
+	EscrowedMintKeyPrefix = []byte("escrowedMint")
+
+	// RefundKeyPrefix prefixes the store keys of the locks made to invalid cosmos receivers, which can be refunded
+	// to their ethereum sender
+	RefundKeyPrefix = []byte("refund")
+)
+
+// GetBridgedSupplyKey returns the store key of the bridged supply of a denom
+func GetBridgedSupplyKey(denom string) []byte {
+	return append(append([]byte{}, BridgedSupplyKeyPrefix...), []byte(denom)...)
+}
+
+// GetMintFeeKey returns the store key of the bridge fee deducted from the mint of a lock prophecy
+func GetMintFeeKey(oracleID string) []byte {
+	return append(append([]byte{}, MintFeeKeyPrefix...), []byte(oracleID)...)
+}
+
+// GetRelayerRewardsKey returns the store key of the bridge fees a validator has earned and not withdrawn
+func GetRelayerRewardsKey(validator sdk.AccAddress) []byte {
+	return append(append([]byte{}, RelayerRewardsKeyPrefix...), validator.Bytes()...)
+}
+
+// GetBridgeStatusKey returns the store key of the last attested status of the bridge contract on an ethereum chain
+func GetBridgeStatusKey(ethereumChainID int) []byte {
+	return append(append([]byte{}, BridgeStatusKeyPrefix...), []byte(":"+strconv.Itoa(ethereumChainID))...)
+}
@@ -28,17 +28,132 @@ func (msg MsgMakeEthBridgeClaim) ValidateBasic() sdk.Error {
 	if msg.EthBridgeClaim.CosmosReceiver.Empty() {
 		return sdk.ErrInvalidAddress(msg.CosmosReceiver.String())
 	}
-	if msg.EthBridgeClaim.Nonce < 0 {
+	if msg.EthBridgeClaim.InvalidReceiver != "" {
+		return ErrInvalidReceiverClaim(DefaultCodespace)
+	}
+	return validateLockClaim(msg.EthBridgeClaim)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgMakeEthBridgeClaim) GetSignBytes() []byte {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgMakeEthBridgeClaim) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.EthBridgeClaim.Validator}
+}
+
+// MsgMakeInvalidReceiverClaim defines a message for claiming a lock whose recipient is not a valid cosmos address,
+// so that it is refunded on ethereum instead of minted
+type MsgMakeInvalidReceiverClaim struct {
+	EthBridgeClaim `json:"eth_bridge_claim"`
+}
+
+// NewMsgMakeInvalidReceiverClaim is a constructor function for MsgMakeInvalidReceiverClaim
+func NewMsgMakeInvalidReceiverClaim(ethBridgeClaim EthBridgeClaim) MsgMakeInvalidReceiverClaim {
+	return MsgMakeInvalidReceiverClaim{ethBridgeClaim}
+}
+
+// Route should return the name of the module
+func (msg MsgMakeInvalidReceiverClaim) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgMakeInvalidReceiverClaim) Type() string { return "make_invalid_receiver_claim" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgMakeInvalidReceiverClaim) ValidateBasic() sdk.Error {
+	if !msg.EthBridgeClaim.CosmosReceiver.Empty() || msg.EthBridgeClaim.InvalidReceiver == "" {
+		return ErrInvalidReceiverClaim(DefaultCodespace)
+	}
+	return validateLockClaim(msg.EthBridgeClaim)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgMakeInvalidReceiverClaim) GetSignBytes() []byte {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgMakeInvalidReceiverClaim) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.EthBridgeClaim.Validator}
+}
+
+// validateLockClaim runs the stateless checks shared by the claims on a lock
+func validateLockClaim(claim EthBridgeClaim) sdk.Error {
+	if claim.EthereumChainID <= 0 {
+		return ErrInvalidChainID(DefaultCodespace)
+	}
+	if !IsValidNonce(claim.Nonce) {
 		return ErrInvalidEthNonce(DefaultCodespace)
 	}
-	if !common.IsValidEthAddress(msg.EthBridgeClaim.EthereumSender) {
+	if !common.IsValidEthAddress(claim.BridgeContractAddress) {
+		return ErrInvalidEthAddress(DefaultCodespace)
+	}
+	if !common.IsValidEthHash(claim.ItemID) {
+		return ErrInvalidEthItemID(DefaultCodespace)
+	}
+	if !common.IsValidEthAddress(claim.EthereumSender) {
 		return ErrInvalidEthAddress(DefaultCodespace)
 	}
+	if err := claim.EthereumProvenance.ValidateBasic(DefaultCodespace); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MsgRevokeEthBridgeClaim defines a message for revoking a lock which was released back on the ethereum bridge
+type MsgRevokeEthBridgeClaim struct {
+	EthBridgeRevocation `json:"eth_bridge_revocation"`
+}
+
+// NewMsgRevokeEthBridgeClaim is a constructor function for MsgRevokeEthBridgeClaim
+func NewMsgRevokeEthBridgeClaim(revocation EthBridgeRevocation) MsgRevokeEthBridgeClaim {
+	return MsgRevokeEthBridgeClaim{revocation}
+}
+
+// Route should return the name of the module
+func (msg MsgRevokeEthBridgeClaim) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgRevokeEthBridgeClaim) Type() string { return "revoke_bridge_claim" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgRevokeEthBridgeClaim) ValidateBasic() sdk.Error {
+	if msg.EthBridgeRevocation.Validator.Empty() {
+		return sdk.ErrInvalidAddress(msg.EthBridgeRevocation.Validator.String())
+	}
+	if msg.EthBridgeRevocation.EthereumChainID <= 0 {
+		return ErrInvalidChainID(DefaultCodespace)
+	}
+	if !IsValidNonce(msg.EthBridgeRevocation.Nonce) {
+		return ErrInvalidEthNonce(DefaultCodespace)
+	}
+	if !common.IsValidEthAddress(msg.EthBridgeRevocation.BridgeContractAddress) {
+		return ErrInvalidEthAddress(DefaultCodespace)
+	}
+	if !common.IsValidEthHash(msg.EthBridgeRevocation.ItemID) {
+		return ErrInvalidEthItemID(DefaultCodespace)
+	}
+	if !common.IsValidEthAddress(msg.EthBridgeRevocation.EthereumSender) {
+		return ErrInvalidEthAddress(DefaultCodespace)
+	}
+	if !IsValidRevocationReason(msg.EthBridgeRevocation.Reason) {
+		return ErrInvalidRevocation(DefaultCodespace)
+	}
 	return nil
 }
 
 // GetSignBytes encodes the message for signing
-func (msg MsgMakeEthBridgeClaim) GetSignBytes() []byte {
+func (msg MsgRevokeEthBridgeClaim) GetSignBytes() []byte {
 	b, err := json.Marshal(msg)
 	if err != nil {
 		panic(err)
@@ -47,6 +162,233 @@ func (msg MsgMakeEthBridgeClaim) GetSignBytes() []byte {
 }
 
 // GetSigners defines whose signature is required
-func (msg MsgMakeEthBridgeClaim) GetSigners() []sdk.AccAddress {
-	return []sdk.AccAddress{msg.EthBridgeClaim.Validator}
+func (msg MsgRevokeEthBridgeClaim) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.EthBridgeRevocation.Validator}
+}
+
+// MsgMakeBridgeStatusClaim defines a message for attesting that locking on the bridge contract was paused or activated
+type MsgMakeBridgeStatusClaim struct {
+	BridgeStatusClaim `json:"bridge_status_claim"`
+}
+
+// NewMsgMakeBridgeStatusClaim is a constructor function for MsgMakeBridgeStatusClaim
+func NewMsgMakeBridgeStatusClaim(statusClaim BridgeStatusClaim) MsgMakeBridgeStatusClaim {
+	return MsgMakeBridgeStatusClaim{statusClaim}
+}
+
+// Route should return the name of the module
+func (msg MsgMakeBridgeStatusClaim) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgMakeBridgeStatusClaim) Type() string { return "make_bridge_status_claim" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgMakeBridgeStatusClaim) ValidateBasic() sdk.Error {
+	if msg.BridgeStatusClaim.Validator.Empty() {
+		return sdk.ErrInvalidAddress(msg.BridgeStatusClaim.Validator.String())
+	}
+	if msg.BridgeStatusClaim.EthereumChainID <= 0 {
+		return ErrInvalidChainID(DefaultCodespace)
+	}
+	if msg.BridgeStatusClaim.EthereumBlock == 0 {
+		return ErrInvalidEthBlock(DefaultCodespace)
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgMakeBridgeStatusClaim) GetSignBytes() []byte {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgMakeBridgeStatusClaim) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.BridgeStatusClaim.Validator}
+}
+
+// MsgSetBridgeStatus defines a message from the bridge admin pausing or resuming minting. While minting is paused,
+// claims are still processed but the coins of successful prophecies are held until it is resumed.
+type MsgSetBridgeStatus struct {
+	Paused bool           `json:"paused"`
+	Admin  sdk.AccAddress `json:"admin"`
+}
+
+// NewMsgSetBridgeStatus is a constructor function for MsgSetBridgeStatus
+func NewMsgSetBridgeStatus(paused bool, admin sdk.AccAddress) MsgSetBridgeStatus {
+	return MsgSetBridgeStatus{
+		Paused: paused,
+		Admin:  admin,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgSetBridgeStatus) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgSetBridgeStatus) Type() string { return "set_bridge_status" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgSetBridgeStatus) ValidateBasic() sdk.Error {
+	if msg.Admin.Empty() {
+		return sdk.ErrInvalidAddress(msg.Admin.String())
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgSetBridgeStatus) GetSignBytes() []byte {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgSetBridgeStatus) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Admin}
+}
+
+// MsgWithdrawRelayerRewards defines a message for a validator to withdraw the bridge fees it has earned by relaying
+// claims
+type MsgWithdrawRelayerRewards struct {
+	Validator sdk.AccAddress `json:"validator"`
+}
+
+// NewMsgWithdrawRelayerRewards is a constructor function for MsgWithdrawRelayerRewards
+func NewMsgWithdrawRelayerRewards(validator sdk.AccAddress) MsgWithdrawRelayerRewards {
+	return MsgWithdrawRelayerRewards{
+		Validator: validator,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgWithdrawRelayerRewards) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgWithdrawRelayerRewards) Type() string { return "withdraw_relayer_rewards" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgWithdrawRelayerRewards) ValidateBasic() sdk.Error {
+	if msg.Validator.Empty() {
+		return sdk.ErrInvalidAddress(msg.Validator.String())
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgWithdrawRelayerRewards) GetSignBytes() []byte {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgWithdrawRelayerRewards) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Validator}
+}
+
+// MsgSetMintLimits defines a message from the bridge admin setting the mint limits. The limits are ethbridge
+// params, which the chain has no governance module to change.
+type MsgSetMintLimits struct {
+	MintLimits MintLimits     `json:"mint_limits"`
+	Admin      sdk.AccAddress `json:"admin"`
+}
+
+// NewMsgSetMintLimits is a constructor function for MsgSetMintLimits
+func NewMsgSetMintLimits(mintLimits MintLimits, admin sdk.AccAddress) MsgSetMintLimits {
+	return MsgSetMintLimits{
+		MintLimits: mintLimits,
+		Admin:      admin,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgSetMintLimits) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgSetMintLimits) Type() string { return "set_mint_limits" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgSetMintLimits) ValidateBasic() sdk.Error {
+	if msg.Admin.Empty() {
+		return sdk.ErrInvalidAddress(msg.Admin.String())
+	}
+	return msg.MintLimits.ValidateBasic(DefaultCodespace)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgSetMintLimits) GetSignBytes() []byte {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgSetMintLimits) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Admin}
+}
+
+// MsgDisputeEscrowedMint defines a message from a validator disputing a mint held in escrow. The mint is cancelled
+// if the validators disputing it hold enough power for consensus before it is released.
+type MsgDisputeEscrowedMint struct {
+	EthereumChainID       int            `json:"ethereum_chain_id"`
+	BridgeContractAddress string         `json:"bridge_contract_address"`
+	Nonce                 sdk.Uint       `json:"nonce"`
+	Validator             sdk.AccAddress `json:"validator"`
+}
+
+// NewMsgDisputeEscrowedMint is a constructor function for MsgDisputeEscrowedMint
+func NewMsgDisputeEscrowedMint(ethereumChainID int, bridgeContractAddress string, nonce sdk.Uint, validator sdk.AccAddress) MsgDisputeEscrowedMint {
+	return MsgDisputeEscrowedMint{
+		EthereumChainID:       ethereumChainID,
+		BridgeContractAddress: bridgeContractAddress,
+		Nonce:                 nonce,
+		Validator:             validator,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgDisputeEscrowedMint) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgDisputeEscrowedMint) Type() string { return "dispute_escrowed_mint" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgDisputeEscrowedMint) ValidateBasic() sdk.Error {
+	if msg.Validator.Empty() {
+		return sdk.ErrInvalidAddress(msg.Validator.String())
+	}
+	if msg.EthereumChainID <= 0 {
+		return ErrInvalidChainID(DefaultCodespace)
+	}
+	if !common.IsValidEthAddress(msg.BridgeContractAddress) {
+		return ErrInvalidEthAddress(DefaultCodespace)
+	}
+	if !IsValidNonce(msg.Nonce) {
+		return ErrInvalidEthNonce(DefaultCodespace)
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgDisputeEscrowedMint) GetSignBytes() []byte {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgDisputeEscrowedMint) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Validator}
 }
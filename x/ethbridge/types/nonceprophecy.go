@@ -0,0 +1,128 @@
+package types
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethCommon "github.com/ethereum/go-ethereum/common"
+)
+
+// NonceProphecy indexes a lock prophecy by a nonce claimed for its lock, so that the prophecies on each nonce of a
+// bridge contract can be found and gaps or conflicts in the contract's nonces detected
+type NonceProphecy struct {
+	Nonce      sdk.Uint   `json:"nonce"`
+	ProphecyID ProphecyID `json:"prophecy_id"`
+	// OracleID is the id the prophecy is stored under in the oracle. It is the key of ProphecyID, except for
+	// prophecies migrated from version 0 ids whose item id was not known, which have an empty item id.
+	OracleID string `json:"oracle_id"`
+}
+
+// NewNonceProphecy is a constructor function for NonceProphecy
+func NewNonceProphecy(nonce sdk.Uint, prophecyID ProphecyID, oracleID string) NonceProphecy {
+	return NonceProphecy{
+		Nonce:      nonce,
+		ProphecyID: prophecyID,
+		OracleID:   oracleID,
+	}
+}
+
+// Key returns the store key of the index entry. Entries are ordered by nonce within each bridge contract.
+func (np NonceProphecy) Key() []byte {
+	key := NonceProphecyNoncePrefix(np.ProphecyID.EthereumChainID, np.ProphecyID.BridgeContractAddress, np.Nonce)
+	if np.ProphecyID.ItemID == "" {
+		return key
+	}
+	return append(key, gethCommon.HexToHash(np.ProphecyID.ItemID).Bytes()...)
+}
+
+// NonceProphecyContractPrefix returns the prefix shared by the index entries of a bridge contract
+func NonceProphecyContractPrefix(ethereumChainID int, bridgeContractAddress string) []byte {
+	return contractKey(NonceProphecyKeyPrefix, ethereumChainID, bridgeContractAddress)
+}
+
+// NonceProphecyNoncePrefix returns the prefix shared by the index entries of a nonce of a bridge contract. The
+// nonce is prefixed with its length, so that entries sort in numeric order of their nonces.
+func NonceProphecyNoncePrefix(ethereumChainID int, bridgeContractAddress string, nonce sdk.Uint) []byte {
+	return append(NonceProphecyContractPrefix(ethereumChainID, bridgeContractAddress), lengthPrefixed(NonceBigInt(nonce).Bytes())...)
+}
+
+// LastFinalizedNonce is the highest nonce of a bridge contract up to which the prophecies on every nonce have been
+// finalized
+type LastFinalizedNonce struct {
+	EthereumChainID       int      `json:"ethereum_chain_id"`
+	BridgeContractAddress string   `json:"bridge_contract_address"`
+	Nonce                 sdk.Uint `json:"nonce"`
+}
+
+// NewLastFinalizedNonce is a constructor function for LastFinalizedNonce
+func NewLastFinalizedNonce(ethereumChainID int, bridgeContractAddress string, nonce sdk.Uint) LastFinalizedNonce {
+	return LastFinalizedNonce{
+		EthereumChainID:       ethereumChainID,
+		BridgeContractAddress: bridgeContractAddress,
+		Nonce:                 nonce,
+	}
+}
+
+// GetLastFinalizedNonceKey returns the store key of the last finalized nonce of a bridge contract
+func GetLastFinalizedNonceKey(ethereumChainID int, bridgeContractAddress string) []byte {
+	return contractKey(LastFinalizedNonceKeyPrefix, ethereumChainID, bridgeContractAddress)
+}
+
+// QueuedMintContractPrefix returns the prefix shared by the queued mints of a bridge contract
+func QueuedMintContractPrefix(ethereumChainID int, bridgeContractAddress string) []byte {
+	return contractKey(QueuedMintKeyPrefix, ethereumChainID, bridgeContractAddress)
+}
+
+// GetQueuedMintKey returns the store key of the mint queued on a nonce of a bridge contract. Only one prophecy can
+// mint a nonce, so each nonce has at most one.
+func GetQueuedMintKey(ethereumChainID int, bridgeContractAddress string, nonce sdk.Uint) []byte {
+	return append(QueuedMintContractPrefix(ethereumChainID, bridgeContractAddress), lengthPrefixed(NonceBigInt(nonce).Bytes())...)
+}
+
+// GetPausedMintKey returns the store key of the mint held on a nonce of a bridge contract while minting is paused.
+// Keys sort by bridge contract and then by nonce, so paused mints are released in the order of their nonces.
+func GetPausedMintKey(ethereumChainID int, bridgeContractAddress string, nonce sdk.Uint) []byte {
+	return append(contractKey(PausedMintKeyPrefix, ethereumChainID, bridgeContractAddress), lengthPrefixed(NonceBigInt(nonce).Bytes())...)
+}
+
+// GetDelayedMintKey returns the store key of the mint delayed on a nonce of a bridge contract by a mint limit. Keys
+// sort by bridge contract and then by nonce, so delayed mints are released in the order of their nonces.
+func GetDelayedMintKey(ethereumChainID int, bridgeContractAddress string, nonce sdk.Uint) []byte {
+	return append(contractKey(DelayedMintKeyPrefix, ethereumChainID, bridgeContractAddress), lengthPrefixed(NonceBigInt(nonce).Bytes())...)
+}
+
+// GetEscrowedMintKey returns the store key of the mint held in escrow on a nonce of a bridge contract. Keys sort by
+// bridge contract and then by nonce, so escrowed mints are released in the order of their nonces.
+func GetEscrowedMintKey(ethereumChainID int, bridgeContractAddress string, nonce sdk.Uint) []byte {
+	return append(contractKey(EscrowedMintKeyPrefix, ethereumChainID, bridgeContractAddress), lengthPrefixed(NonceBigInt(nonce).Bytes())...)
+}
+
+// ContractFromLastFinalizedNonceKey returns the ethereum chain id and bridge contract address of the store key of a
+// last finalized nonce, or false if it is not the key of one
+func ContractFromLastFinalizedNonceKey(key []byte) (int, string, bool) {
+	if len(key) < len(LastFinalizedNonceKeyPrefix) || !bytes.Equal(key[:len(LastFinalizedNonceKeyPrefix)], LastFinalizedNonceKeyPrefix) {
+		return 0, "", false
+	}
+	chainID, rest, ok := readLengthPrefixed(key[len(LastFinalizedNonceKeyPrefix):])
+	if !ok || len(chainID) != 8 {
+		return 0, "", false
+	}
+	contract, rest, ok := readLengthPrefixed(rest)
+	if !ok || len(contract) != gethCommon.AddressLength || len(rest) != 0 {
+		return 0, "", false
+	}
+	return int(binary.BigEndian.Uint64(chainID)), gethCommon.BytesToAddress(contract).Hex(), true
+}
+
+// NonceBigInt returns a nonce as a big.Int, whose big-endian bytes order the store keys of nonces numerically
+func NonceBigInt(nonce sdk.Uint) *big.Int {
+	n, _ := new(big.Int).SetString(nonce.String(), 10)
+	return n
+}
+
+func contractKey(prefix []byte, ethereumChainID int, bridgeContractAddress string) []byte {
+	key := append(append([]byte{}, prefix...), lengthPrefixed(chainIDBytes(ethereumChainID))...)
+	return append(key, lengthPrefixed(gethCommon.HexToAddress(bridgeContractAddress).Bytes())...)
+}
@@ -0,0 +1,123 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+// Params store keys
+var (
+	// KeySequentialMinting is the params store key of whether locks are minted in the order of their nonces
+	KeySequentialMinting = []byte("SequentialMinting")
+	// KeyAdmin is the params store key of the account allowed to pause and resume minting
+	KeyAdmin = []byte("Admin")
+	// KeyBridgeFeeFlat is the params store key of the flat fee deducted from each mint
+	KeyBridgeFeeFlat = []byte("BridgeFeeFlat")
+	// KeyBridgeFeeBasisPoints is the params store key of the fee deducted from each mint in basis points
+	KeyBridgeFeeBasisPoints = []byte("BridgeFeeBasisPoints")
+	// KeyMintLimitWindow is the params store key of the number of blocks mint limits apply over
+	KeyMintLimitWindow = []byte("MintLimitWindow")
+	// KeyGlobalMintLimit is the params store key of the coins which may be minted in a window
+	KeyGlobalMintLimit = []byte("GlobalMintLimit")
+	// KeyReceiverMintLimit is the params store key of the coins which may be minted to one receiver in a window
+	KeyReceiverMintLimit = []byte("ReceiverMintLimit")
+	// KeyEscrowThreshold is the params store key of the amounts above which mints are held in escrow
+	KeyEscrowThreshold = []byte("EscrowThreshold")
+	// KeyEscrowBlocks is the params store key of the number of blocks mints are held in escrow for
+	KeyEscrowBlocks = []byte("EscrowBlocks")
+)
+
+// Params are the ethbridge parameters, set at genesis. The bridge admin can change the mint limits.
+type Params struct {
+	// SequentialMinting holds back the mint of a successful prophecy until the prophecies on every earlier nonce
+	// of its bridge contract have been finalized
+	SequentialMinting bool `json:"sequential_minting"`
+	// Admin is the account allowed to pause and resume minting. Minting cannot be paused when it is empty.
+	Admin sdk.AccAddress `json:"admin"`
+	// BridgeFeeFlat is the flat fee of each denom deducted from a mint of that denom
+	BridgeFeeFlat sdk.Coins `json:"bridge_fee_flat"`
+	// BridgeFeeBasisPoints is the fee deducted from each mint in basis points of its amount, on top of the flat fee
+	BridgeFeeBasisPoints uint64 `json:"bridge_fee_basis_points"`
+	// MintLimitWindow is the number of blocks, ending with the current block, which the mint limits apply over.
+	// Mints are not limited when it is 0.
+	MintLimitWindow int64 `json:"mint_limit_window"`
+	// GlobalMintLimit is the amount of each denom which may be minted in a window. Denoms it does not list are not
+	// limited.
+	GlobalMintLimit sdk.Coins `json:"global_mint_limit"`
+	// ReceiverMintLimit is the amount of each denom which may be minted to one receiver in a window. Denoms it does
+	// not list are not limited.
+	ReceiverMintLimit sdk.Coins `json:"receiver_mint_limit"`
+	// EscrowThreshold is the amount of each denom above which a mint is held in escrow, where validators can dispute
+	// it. Denoms it does not list are never escrowed.
+	EscrowThreshold sdk.Coins `json:"escrow_threshold"`
+	// EscrowBlocks is the number of blocks a mint is held in escrow for. Mints are not escrowed when it is 0.
+	EscrowBlocks int64 `json:"escrow_blocks"`
+}
+
+// NewParams is a constructor function for Params
+func NewParams(sequentialMinting bool, admin sdk.AccAddress, bridgeFeeFlat sdk.Coins, bridgeFeeBasisPoints uint64, mintLimits MintLimits,
+	escrowThreshold sdk.Coins, escrowBlocks int64) Params {
+	return Params{
+		SequentialMinting:    sequentialMinting,
+		Admin:                admin,
+		BridgeFeeFlat:        bridgeFeeFlat,
+		BridgeFeeBasisPoints: bridgeFeeBasisPoints,
+		MintLimitWindow:      mintLimits.Window,
+		GlobalMintLimit:      mintLimits.GlobalLimit,
+		ReceiverMintLimit:    mintLimits.ReceiverLimit,
+		EscrowThreshold:      escrowThreshold,
+		EscrowBlocks:         escrowBlocks,
+	}
+}
+
+// IsEscrowed returns true if a mint of amount is held in escrow
+func (p Params) IsEscrowed(amount sdk.Coins) bool {
+	return p.EscrowBlocks > 0 && ExceedsEscrowThreshold(amount, p.EscrowThreshold)
+}
+
+// MintLimits returns the mint limits set by the params
+func (p Params) MintLimits() MintLimits {
+	return NewMintLimits(p.MintLimitWindow, p.GlobalMintLimit, p.ReceiverMintLimit)
+}
+
+// DefaultParams returns the params of a new chain, which mint each lock as soon as its prophecy succeeds, have no
+// admin, charge no bridge fee and neither limit nor escrow mints
+func DefaultParams() Params {
+	return NewParams(false, nil, sdk.Coins{}, 0, NewMintLimits(0, sdk.Coins{}, sdk.Coins{}), sdk.Coins{}, 0)
+}
+
+// ParamKeyTable returns the key table of the ethbridge params
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		{Key: KeySequentialMinting, Value: &p.SequentialMinting},
+		{Key: KeyAdmin, Value: &p.Admin},
+		{Key: KeyBridgeFeeFlat, Value: &p.BridgeFeeFlat},
+		{Key: KeyBridgeFeeBasisPoints, Value: &p.BridgeFeeBasisPoints},
+		{Key: KeyMintLimitWindow, Value: &p.MintLimitWindow},
+		{Key: KeyGlobalMintLimit, Value: &p.GlobalMintLimit},
+		{Key: KeyReceiverMintLimit, Value: &p.ReceiverMintLimit},
+		{Key: KeyEscrowThreshold, Value: &p.EscrowThreshold},
+		{Key: KeyEscrowBlocks, Value: &p.EscrowBlocks},
+	}
+}
+
+func (p Params) String() string {
+	return fmt.Sprintf(`Params:
+  SequentialMinting:    %t
+  Admin:                %s
+  BridgeFeeFlat:        %s
+  BridgeFeeBasisPoints: %d
+  MintLimitWindow:      %d
+  GlobalMintLimit:      %s
+  ReceiverMintLimit:    %s
+  EscrowThreshold:      %s
+  EscrowBlocks:         %d`, p.SequentialMinting, p.Admin, p.BridgeFeeFlat, p.BridgeFeeBasisPoints,
+		p.MintLimitWindow, p.GlobalMintLimit, p.ReceiverMintLimit, p.EscrowThreshold, p.EscrowBlocks)
+}
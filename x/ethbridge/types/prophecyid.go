@@ -0,0 +1,119 @@
+package types
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	gethCommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/swishlabsco/cosmos-ethereum-bridge/x/ethbridge/common"
+)
+
+// Prefixes of the oracle store keys of lock and revocation prophecies. Bridge status prophecies are stored under
+// text ids, which never start with these bytes.
+var (
+	LockProphecyKeyPrefix       = []byte{0x01}
+	RevocationProphecyKeyPrefix = []byte{0x02}
+)
+
+// ProphecyID identifies the prophecy on a lock by the ethereum chain and bridge contract the lock was made on, and
+// the id the contract gave the locked item. Item ids are assigned by the contract, so a validator cannot make up
+// a prophecy for a lock which was never made by choosing its own nonce or sender.
+type ProphecyID struct {
+	EthereumChainID       int    `json:"ethereum_chain_id"`
+	BridgeContractAddress string `json:"bridge_contract_address"`
+	ItemID                string `json:"item_id"`
+}
+
+// NewProphecyID is a constructor function for ProphecyID. Valid contract addresses are checksummed and valid item
+// ids lowercased, so that ids read back from a store key are equal to the ids they were made from.
+func NewProphecyID(ethereumChainID int, bridgeContractAddress string, itemID string) ProphecyID {
+	if common.IsValidEthAddress(bridgeContractAddress) {
+		bridgeContractAddress = gethCommon.HexToAddress(bridgeContractAddress).Hex()
+	}
+	if common.IsValidEthHash(itemID) {
+		itemID = gethCommon.HexToHash(itemID).Hex()
+	}
+	return ProphecyID{
+		EthereumChainID:       ethereumChainID,
+		BridgeContractAddress: bridgeContractAddress,
+		ItemID:                itemID,
+	}
+}
+
+// String returns the id in a readable form, for logs and the CLI
+func (id ProphecyID) String() string {
+	return fmt.Sprintf("%d:%s:%s", id.EthereumChainID, id.BridgeContractAddress, id.ItemID)
+}
+
+// Key returns the oracle store key of the prophecy on the lock
+func (id ProphecyID) Key() []byte {
+	return append(ChainProphecyKeyPrefix(id.EthereumChainID), id.keySuffix()...)
+}
+
+// RevocationKey returns the oracle store key of the prophecy revoking the lock
+func (id ProphecyID) RevocationKey() []byte {
+	key := append(append([]byte{}, RevocationProphecyKeyPrefix...), lengthPrefixed(chainIDBytes(id.EthereumChainID))...)
+	return append(key, id.keySuffix()...)
+}
+
+// keySuffix returns the contract address and item id parts of the id's store keys
+func (id ProphecyID) keySuffix() []byte {
+	contract := gethCommon.HexToAddress(id.BridgeContractAddress).Bytes()
+	item := gethCommon.HexToHash(id.ItemID).Bytes()
+	return append(lengthPrefixed(contract), lengthPrefixed(item)...)
+}
+
+// ChainProphecyKeyPrefix returns the prefix shared by the store keys of the prophecies on locks made on an ethereum
+// chain. Each part of a key is prefixed with its length, so that no two ids share a key and no key of one chain
+// starts with the prefix of another.
+func ChainProphecyKeyPrefix(ethereumChainID int) []byte {
+	return append(append([]byte{}, LockProphecyKeyPrefix...), lengthPrefixed(chainIDBytes(ethereumChainID))...)
+}
+
+// ProphecyIDFromKey returns the id of a lock prophecy from its store key, or false if it is not the key of one
+func ProphecyIDFromKey(key []byte) (ProphecyID, bool) {
+	if len(key) < len(LockProphecyKeyPrefix) || key[0] != LockProphecyKeyPrefix[0] {
+		return ProphecyID{}, false
+	}
+	rest := key[len(LockProphecyKeyPrefix):]
+
+	chainID, rest, ok := readLengthPrefixed(rest)
+	if !ok || len(chainID) != 8 {
+		return ProphecyID{}, false
+	}
+	contract, rest, ok := readLengthPrefixed(rest)
+	if !ok || len(contract) != gethCommon.AddressLength {
+		return ProphecyID{}, false
+	}
+	item, rest, ok := readLengthPrefixed(rest)
+	if !ok || len(item) != gethCommon.HashLength || len(rest) != 0 {
+		return ProphecyID{}, false
+	}
+
+	return NewProphecyID(
+		int(binary.BigEndian.Uint64(chainID)),
+		gethCommon.BytesToAddress(contract).Hex(),
+		gethCommon.BytesToHash(item).Hex(),
+	), true
+}
+
+func chainIDBytes(ethereumChainID int) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(ethereumChainID))
+	return bz
+}
+
+// lengthPrefixed prefixes bz, which is at most 255 bytes long, with its length
+func lengthPrefixed(bz []byte) []byte {
+	return append([]byte{byte(len(bz))}, bz...)
+}
+
+// readLengthPrefixed reads a length prefixed part of a key, returning the part and the rest of the key
+func readLengthPrefixed(key []byte) ([]byte, []byte, bool) {
+	if len(key) == 0 || len(key) < 1+int(key[0]) {
+		return nil, nil, false
+	}
+	end := 1 + int(key[0])
+	return key[1:end], key[end:], true
+}
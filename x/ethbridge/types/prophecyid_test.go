@@ -0,0 +1,61 @@
+package types
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestProphecyIDKey(t *testing.T) {
+	//Keys are read back as the ids they were made from, with the contract address checksummed and the item id
+	//lowercased
+	for _, id := range []ProphecyID{
+		NewProphecyID(TestEthereumChainID, TestBridgeContract, TestEthereumItemID),
+		NewProphecyID(TestEthereumChainID, strings.ToLower(TestBridgeContract), AltTestEthereumItemID),
+		NewProphecyID(1<<40, TestEthereumAddress, "0x"+strings.ToUpper(AltTestEthereumItemID[2:])),
+	} {
+		readID, ok := ProphecyIDFromKey(id.Key())
+		require.True(t, ok)
+		require.Equal(t, id.String(), readID.String())
+		require.True(t, bytes.HasPrefix(id.Key(), ChainProphecyKeyPrefix(id.EthereumChainID)))
+
+		_, ok = ProphecyIDFromKey(id.RevocationKey())
+		require.False(t, ok)
+	}
+	id := NewProphecyID(TestEthereumChainID, strings.ToLower(TestBridgeContract), "0x"+strings.ToUpper(TestEthereumItemID[2:]))
+	require.Equal(t, TestBridgeContract, id.BridgeContractAddress)
+	require.Equal(t, TestEthereumItemID, id.ItemID)
+
+	//Keys which are not those of lock prophecies are not read as ids
+	_, ok := ProphecyIDFromKey([]byte(CreateBridgeStatusProphecyID(TestEthereumChainID, 100)))
+	require.False(t, ok)
+	_, ok = ProphecyIDFromKey([]byte("3:00x7B95B6EC7EbD73572298cEf32Bb54FA408207359"))
+	require.False(t, ok)
+	key := NewProphecyID(TestEthereumChainID, TestBridgeContract, TestEthereumItemID).Key()
+	_, ok = ProphecyIDFromKey(key[:len(key)-1])
+	require.False(t, ok)
+}
+
+func TestProphecyIDKeysAreDistinct(t *testing.T) {
+	//Ids which only differ in one part have distinct keys, and no key of one chain starts with the prefix of another
+	ids := []ProphecyID{
+		NewProphecyID(1, TestBridgeContract, TestEthereumItemID),
+		NewProphecyID(11, TestBridgeContract, TestEthereumItemID),
+		NewProphecyID(1, TestEthereumAddress, TestEthereumItemID),
+		NewProphecyID(1, TestBridgeContract, AltTestEthereumItemID),
+		NewProphecyID(256, TestBridgeContract, TestEthereumItemID),
+	}
+	keys := make(map[string]bool)
+	for _, id := range ids {
+		keys[string(id.Key())] = true
+		keys[string(id.RevocationKey())] = true
+		for _, other := range ids {
+			if other.EthereumChainID != id.EthereumChainID {
+				require.False(t, bytes.HasPrefix(id.Key(), ChainProphecyKeyPrefix(other.EthereumChainID)))
+			}
+		}
+	}
+	require.Len(t, keys, 2*len(ids))
+}
@@ -0,0 +1,37 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/swishlabsco/cosmos-ethereum-bridge/x/ethbridge/common"
+)
+
+// EthereumProvenance identifies the ethereum log a lock claim was made from, so that competing claims on the same
+// prophecy can be told apart and each mint can be traced back to the lock on ethereum. Its fields are empty for
+// claims made by hand without them.
+type EthereumProvenance struct {
+	TxHash      string `json:"tx_hash"`
+	BlockNumber uint64 `json:"block_number"`
+	BlockHash   string `json:"block_hash"`
+	LogIndex    uint64 `json:"log_index"`
+}
+
+// NewEthereumProvenance is a constructor function for EthereumProvenance
+func NewEthereumProvenance(txHash string, blockNumber uint64, blockHash string, logIndex uint64) EthereumProvenance {
+	return EthereumProvenance{
+		TxHash:      txHash,
+		BlockNumber: blockNumber,
+		BlockHash:   blockHash,
+		LogIndex:    logIndex,
+	}
+}
+
+// ValidateBasic checks that each hash which is set is a valid 32 byte hash
+func (provenance EthereumProvenance) ValidateBasic(codespace sdk.CodespaceType) sdk.Error {
+	if provenance.TxHash != "" && !common.IsValidEthHash(provenance.TxHash) {
+		return ErrInvalidEthTxHash(codespace)
+	}
+	if provenance.BlockHash != "" && !common.IsValidEthHash(provenance.BlockHash) {
+		return ErrInvalidEthProvenance(codespace, "block hash")
+	}
+	return nil
+}
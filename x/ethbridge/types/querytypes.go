@@ -3,32 +3,115 @@ package types
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/swishlabsco/cosmos-ethereum-bridge/x/oracle"
 )
 
 // defines the params for the following queries:
 // - 'custom/ethbridge/prophecies/'
 type QueryEthProphecyParams struct {
-	Nonce          int
-	EthereumSender string
+	ProphecyID ProphecyID
 }
 
-func NewQueryEthProphecyParams(nonce int, ethereumSender string) QueryEthProphecyParams {
+func NewQueryEthProphecyParams(prophecyID ProphecyID) QueryEthProphecyParams {
 	return QueryEthProphecyParams{
-		Nonce:          nonce,
+		ProphecyID: prophecyID,
+	}
+}
+
+// defines the params for the following queries:
+// - 'custom/ethbridge/nonce-prophecies/'
+type QueryNoncePropheciesParams struct {
+	EthereumChainID       int
+	BridgeContractAddress string
+	Nonce                 sdk.Uint
+}
+
+func NewQueryNoncePropheciesParams(ethereumChainID int, bridgeContractAddress string, nonce sdk.Uint) QueryNoncePropheciesParams {
+	return QueryNoncePropheciesParams{
+		EthereumChainID:       ethereumChainID,
+		BridgeContractAddress: bridgeContractAddress,
+		Nonce:                 nonce,
+	}
+}
+
+// DefaultNonceGapsLimit is the number of gaps a nonce gaps query lists when it is not given a limit
+const DefaultNonceGapsLimit = 100
+
+// defines the params for the following queries:
+// - 'custom/ethbridge/nonce-gaps/'
+type QueryNonceGapsParams struct {
+	EthereumChainID       int
+	BridgeContractAddress string
+	Limit                 int
+}
+
+func NewQueryNonceGapsParams(ethereumChainID int, bridgeContractAddress string, limit int) QueryNonceGapsParams {
+	return QueryNonceGapsParams{
+		EthereumChainID:       ethereumChainID,
+		BridgeContractAddress: bridgeContractAddress,
+		Limit:                 limit,
+	}
+}
+
+// defines the params for the following queries:
+// - 'custom/ethbridge/pending-prophecies/'
+type QueryPendingPropheciesParams struct {
+	EthereumChainID int
+}
+
+func NewQueryPendingPropheciesParams(ethereumChainID int) QueryPendingPropheciesParams {
+	return QueryPendingPropheciesParams{
+		EthereumChainID: ethereumChainID,
+	}
+}
+
+// defines the params for the following queries:
+// - 'custom/ethbridge/status/'
+type QueryBridgeStatusParams struct {
+	EthereumChainID int
+}
+
+func NewQueryBridgeStatusParams(ethereumChainID int) QueryBridgeStatusParams {
+	return QueryBridgeStatusParams{
+		EthereumChainID: ethereumChainID,
+	}
+}
+
+// defines the params for the following queries:
+// - 'custom/ethbridge/relayer-rewards/'
+type QueryRelayerRewardsParams struct {
+	Validator sdk.AccAddress
+}
+
+func NewQueryRelayerRewardsParams(validator sdk.AccAddress) QueryRelayerRewardsParams {
+	return QueryRelayerRewardsParams{
+		Validator: validator,
+	}
+}
+
+// defines the params for the following queries:
+// - 'custom/ethbridge/refunds/'
+type QueryRefundsParams struct {
+	EthereumSender string
+}
+
+func NewQueryRefundsParams(ethereumSender string) QueryRefundsParams {
+	return QueryRefundsParams{
 		EthereumSender: ethereumSender,
 	}
 }
 
 // Query Result Payload for an eth prophecy query
 type QueryEthProphecyResponse struct {
-	ID              string           `json:"id"`
+	ID              ProphecyID       `json:"id"`
 	Status          oracle.Status    `json:"status"`
 	EthBridgeClaims []EthBridgeClaim `json:"claims"`
 }
 
-func NewQueryEthProphecyResponse(id string, status oracle.Status, claims []EthBridgeClaim) QueryEthProphecyResponse {
+func NewQueryEthProphecyResponse(id ProphecyID, status oracle.Status, claims []EthBridgeClaim) QueryEthProphecyResponse {
 	return QueryEthProphecyResponse{
 		ID:              id,
 		Status:          status,
@@ -44,3 +127,250 @@ func (response QueryEthProphecyResponse) String() string {
 
 	return string(prophecyJSON)
 }
+
+// Query Result Payload for a query listing eth prophecies
+type QueryEthPropheciesResponse []QueryEthProphecyResponse
+
+func (response QueryEthPropheciesResponse) String() string {
+	propheciesJSON, err := json.Marshal(response)
+	if err != nil {
+		return fmt.Sprintf("Error marshalling json: %v", err)
+	}
+
+	return string(propheciesJSON)
+}
+
+// Statuses of the nonces listed as gaps
+const (
+	// NonceGapMissing is the status of a nonce no validator has claimed a lock for
+	NonceGapMissing = "missing"
+	// NonceGapPending is the status of a nonce whose prophecies are all still pending
+	NonceGapPending = "pending"
+)
+
+// NonceGap is a nonce of a bridge contract after its last finalized nonce which has not been finalized
+type NonceGap struct {
+	Nonce       sdk.Uint     `json:"nonce"`
+	Status      string       `json:"status"`
+	ProphecyIDs []ProphecyID `json:"prophecy_ids"`
+}
+
+func NewNonceGap(nonce sdk.Uint, status string, prophecyIDs []ProphecyID) NonceGap {
+	return NonceGap{
+		Nonce:       nonce,
+		Status:      status,
+		ProphecyIDs: prophecyIDs,
+	}
+}
+
+// Query Result Payload for a nonce gaps query
+type QueryNonceGapsResponse struct {
+	LastFinalizedNonce  sdk.Uint   `json:"last_finalized_nonce"`
+	HighestClaimedNonce sdk.Uint   `json:"highest_claimed_nonce"`
+	Gaps                []NonceGap `json:"gaps"`
+	QueuedNonces        []sdk.Uint `json:"queued_nonces"`
+}
+
+func NewQueryNonceGapsResponse(lastFinalizedNonce sdk.Uint, highestClaimedNonce sdk.Uint, gaps []NonceGap, queuedNonces []sdk.Uint) QueryNonceGapsResponse {
+	return QueryNonceGapsResponse{
+		LastFinalizedNonce:  lastFinalizedNonce,
+		HighestClaimedNonce: highestClaimedNonce,
+		Gaps:                gaps,
+		QueuedNonces:        queuedNonces,
+	}
+}
+
+func (response QueryNonceGapsResponse) String() string {
+	gapsJSON, err := json.Marshal(response)
+	if err != nil {
+		return fmt.Sprintf("Error marshalling json: %v", err)
+	}
+
+	return string(gapsJSON)
+}
+
+// Statuses of minting
+const (
+	// MintingStatusActive is the status of the bridge while the coins of successful prophecies are minted
+	MintingStatusActive = "active"
+	// MintingStatusPaused is the status of the bridge while the coins of successful prophecies are held
+	MintingStatusPaused = "paused"
+)
+
+// Query Result Payload for a minting status query
+type QueryMintingStatusResponse struct {
+	Status      string         `json:"status"`
+	Admin       sdk.AccAddress `json:"admin"`
+	PausedMints int            `json:"paused_mints"`
+}
+
+func NewQueryMintingStatusResponse(paused bool, admin sdk.AccAddress, pausedMints int) QueryMintingStatusResponse {
+	status := MintingStatusActive
+	if paused {
+		status = MintingStatusPaused
+	}
+	return QueryMintingStatusResponse{
+		Status:      status,
+		Admin:       admin,
+		PausedMints: pausedMints,
+	}
+}
+
+func (response QueryMintingStatusResponse) String() string {
+	return fmt.Sprintf("minting %s, %d mints held, admin %s", response.Status, response.PausedMints, response.Admin)
+}
+
+// Query Result Payload for a supply query
+type QuerySupplyResponse struct {
+	ModuleAccount sdk.AccAddress `json:"module_account"`
+	Minted        sdk.Coins      `json:"minted"`
+	Burned        sdk.Coins      `json:"burned"`
+	Supply        sdk.Coins      `json:"supply"`
+}
+
+func NewQuerySupplyResponse(moduleAccount sdk.AccAddress, minted sdk.Coins, burned sdk.Coins, supply sdk.Coins) QuerySupplyResponse {
+	return QuerySupplyResponse{
+		ModuleAccount: moduleAccount,
+		Minted:        minted,
+		Burned:        burned,
+		Supply:        supply,
+	}
+}
+
+func (response QuerySupplyResponse) String() string {
+	return fmt.Sprintf(`Module account: %s
+Minted:         %s
+Burned:         %s
+Supply:         %s`, response.ModuleAccount, response.Minted, response.Burned, response.Supply)
+}
+
+// Query Result Payload for a fee pool query
+type QueryFeePoolResponse struct {
+	// Rewards are the bridge fees validators have earned and not withdrawn
+	Rewards []RelayerReward `json:"rewards"`
+	// Undistributed are the bridge fees left over by rounding, which are added to the next fee
+	Undistributed sdk.Coins `json:"undistributed"`
+	// Total is all the bridge fees held by the ethbridge module account
+	Total sdk.Coins `json:"total"`
+}
+
+func NewQueryFeePoolResponse(rewards []RelayerReward, undistributed sdk.Coins) QueryFeePoolResponse {
+	total := undistributed
+	for _, reward := range rewards {
+		total = total.Add(reward.Rewards)
+	}
+	return QueryFeePoolResponse{
+		Rewards:       rewards,
+		Undistributed: undistributed,
+		Total:         total,
+	}
+}
+
+func (response QueryFeePoolResponse) String() string {
+	poolJSON, err := json.Marshal(response)
+	if err != nil {
+		return fmt.Sprintf("Error marshalling json: %v", err)
+	}
+
+	return string(poolJSON)
+}
+
+// Query Result Payload for a mint limits query
+type QueryMintLimitsResponse struct {
+	MintLimits MintLimits `json:"mint_limits"`
+	// WindowMinted are the coins minted in the current window
+	WindowMinted sdk.Coins `json:"window_minted"`
+	// DelayedMints is the number of mints delayed until they fit in the limits
+	DelayedMints int `json:"delayed_mints"`
+}
+
+func NewQueryMintLimitsResponse(mintLimits MintLimits, windowMinted sdk.Coins, delayedMints int) QueryMintLimitsResponse {
+	return QueryMintLimitsResponse{
+		MintLimits:   mintLimits,
+		WindowMinted: windowMinted,
+		DelayedMints: delayedMints,
+	}
+}
+
+func (response QueryMintLimitsResponse) String() string {
+	return fmt.Sprintf(`%s
+Minted in window: %s
+Delayed mints:    %d`, response.MintLimits, response.WindowMinted, response.DelayedMints)
+}
+
+// Query Result Payload for each mint in a delayed mints query
+type QueryDelayedMintResponse struct {
+	Prophecy  QueryEthProphecyResponse `json:"prophecy"`
+	DelayedAt int64                    `json:"delayed_at"`
+}
+
+func NewQueryDelayedMintResponse(prophecy QueryEthProphecyResponse, delayedAt int64) QueryDelayedMintResponse {
+	return QueryDelayedMintResponse{
+		Prophecy:  prophecy,
+		DelayedAt: delayedAt,
+	}
+}
+
+func (response QueryDelayedMintResponse) String() string {
+	return fmt.Sprintf("delayed at block %d: %s", response.DelayedAt, response.Prophecy)
+}
+
+// Query Result Payload for a delayed mints query
+type QueryDelayedMintsResponse []QueryDelayedMintResponse
+
+func (response QueryDelayedMintsResponse) String() string {
+	mints := make([]string, len(response))
+	for i, mint := range response {
+		mints[i] = mint.String()
+	}
+	return strings.Join(mints, "\n")
+}
+
+const (
+	// EscrowStatusDisputed is logged when a dispute of an escrowed mint does not yet have enough power to cancel it
+	EscrowStatusDisputed = "disputed"
+	// EscrowStatusCancelled is logged when the disputes of an escrowed mint cancel it
+	EscrowStatusCancelled = "cancelled"
+)
+
+// Query Result Payload for each mint in an escrowed mints query
+type QueryEscrowedMintResponse struct {
+	Prophecy      QueryEthProphecyResponse `json:"prophecy"`
+	ReleaseHeight int64                    `json:"release_height"`
+	Disputes      []sdk.ValAddress         `json:"disputes"`
+}
+
+func NewQueryEscrowedMintResponse(prophecy QueryEthProphecyResponse, releaseHeight int64, disputes []sdk.ValAddress) QueryEscrowedMintResponse {
+	return QueryEscrowedMintResponse{
+		Prophecy:      prophecy,
+		ReleaseHeight: releaseHeight,
+		Disputes:      disputes,
+	}
+}
+
+func (response QueryEscrowedMintResponse) String() string {
+	return fmt.Sprintf("released at block %d, disputed by %v: %s", response.ReleaseHeight, response.Disputes, response.Prophecy)
+}
+
+// Query Result Payload for an escrowed mints query
+type QueryEscrowedMintsResponse []QueryEscrowedMintResponse
+
+func (response QueryEscrowedMintsResponse) String() string {
+	mints := make([]string, len(response))
+	for i, mint := range response {
+		mints[i] = mint.String()
+	}
+	return strings.Join(mints, "\n")
+}
+
+// Query Result Payload for a refunds query
+type QueryRefundsResponse []Refund
+
+func (response QueryRefundsResponse) String() string {
+	refundsJSON, err := json.Marshal(response)
+	if err != nil {
+		return fmt.Sprintf("Error marshalling json: %v", err)
+	}
+
+	return string(refundsJSON)
+}
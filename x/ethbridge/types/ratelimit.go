@@ -0,0 +1,116 @@
+package types
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MintLimits limit the coins the bridge mints over a rolling window of blocks, so that a faulty or compromised
+// oracle cannot mint without bound before the bridge admin pauses minting. Mints which would go over a limit are
+// delayed until they fit.
+type MintLimits struct {
+	// Window is the number of blocks, ending with the current block, the limits apply over. Mints are not limited
+	// when it is 0.
+	Window int64 `json:"window"`
+	// GlobalLimit is the amount of each denom which may be minted in a window
+	GlobalLimit sdk.Coins `json:"global_limit"`
+	// ReceiverLimit is the amount of each denom which may be minted to one receiver in a window
+	ReceiverLimit sdk.Coins `json:"receiver_limit"`
+}
+
+// NewMintLimits is a constructor function for MintLimits
+func NewMintLimits(window int64, globalLimit sdk.Coins, receiverLimit sdk.Coins) MintLimits {
+	return MintLimits{
+		Window:        window,
+		GlobalLimit:   globalLimit,
+		ReceiverLimit: receiverLimit,
+	}
+}
+
+// Enabled returns true if the limits apply to mints
+func (limits MintLimits) Enabled() bool {
+	return limits.Window > 0
+}
+
+// ValidateBasic checks that the window is not negative and the limits are valid coins
+func (limits MintLimits) ValidateBasic(codespace sdk.CodespaceType) sdk.Error {
+	if limits.Window < 0 {
+		return ErrInvalidMintLimits(codespace, "window cannot be negative")
+	}
+	if !limits.GlobalLimit.IsValid() {
+		return ErrInvalidMintLimits(codespace, fmt.Sprintf("invalid global limit %s", limits.GlobalLimit))
+	}
+	if !limits.ReceiverLimit.IsValid() {
+		return ErrInvalidMintLimits(codespace, fmt.Sprintf("invalid receiver limit %s", limits.ReceiverLimit))
+	}
+	return nil
+}
+
+func (limits MintLimits) String() string {
+	return fmt.Sprintf(`MintLimits:
+  Window:        %d
+  GlobalLimit:   %s
+  ReceiverLimit: %s`, limits.Window, limits.GlobalLimit, limits.ReceiverLimit)
+}
+
+// ExceedsMintLimit returns true if minting amount on top of the coins already minted in the window would take a
+// denom over its limit. Denoms the limit does not list are not limited.
+func ExceedsMintLimit(minted sdk.Coins, amount sdk.Coins, limit sdk.Coins) bool {
+	for _, coin := range amount {
+		max := limit.AmountOf(coin.Denom)
+		if max.IsZero() {
+			continue
+		}
+		if minted.AmountOf(coin.Denom).Add(coin.Amount).GT(max) {
+			return true
+		}
+	}
+	return false
+}
+
+// DelayedMint is the mint of a successful prophecy which would have gone over a mint limit, delayed until it fits
+type DelayedMint struct {
+	NonceProphecy NonceProphecy `json:"nonce_prophecy"`
+	// DelayedAt is the block height the mint was delayed at
+	DelayedAt int64 `json:"delayed_at"`
+}
+
+// NewDelayedMint is a constructor function for DelayedMint
+func NewDelayedMint(nonceProphecy NonceProphecy, delayedAt int64) DelayedMint {
+	return DelayedMint{
+		NonceProphecy: nonceProphecy,
+		DelayedAt:     delayedAt,
+	}
+}
+
+// WindowMint is the coins minted to a receiver at a block height, which count against the mint limits until the
+// height leaves the window
+type WindowMint struct {
+	Height   int64          `json:"height"`
+	Receiver sdk.AccAddress `json:"receiver"`
+	Amount   sdk.Coins      `json:"amount"`
+}
+
+// NewWindowMint is a constructor function for WindowMint
+func NewWindowMint(height int64, receiver sdk.AccAddress, amount sdk.Coins) WindowMint {
+	return WindowMint{
+		Height:   height,
+		Receiver: receiver,
+		Amount:   amount,
+	}
+}
+
+// WindowMintPrefix returns the prefix of the store keys of the coins minted to each receiver at a block height.
+// Keys sort by height, so the mints in a window are a range of keys.
+func WindowMintPrefix(height int64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(height))
+	return append(append([]byte{}, WindowMintKeyPrefix...), bz...)
+}
+
+// GetWindowMintKey returns the store key of the coins minted to a receiver at a block height
+func GetWindowMintKey(height int64, receiver sdk.AccAddress) []byte {
+	return append(WindowMintPrefix(height), receiver.Bytes()...)
+}
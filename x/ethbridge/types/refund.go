@@ -0,0 +1,48 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethCommon "github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	// RefundStatusRefundable is the status of a refund whose lock can be unlocked back to its ethereum sender
+	RefundStatusRefundable = "refundable"
+	// RefundStatusRefunded is the status of a refund whose lock was since unlocked or withdrawn on ethereum
+	RefundStatusRefunded = "refunded"
+)
+
+// Refund records a lock whose prophecy succeeded on a claim that its recipient is not a valid cosmos address.
+// Nothing is minted for it, so the coins locked on ethereum can be unlocked back to the ethereum sender.
+type Refund struct {
+	ProphecyID     ProphecyID `json:"prophecy_id"`
+	Nonce          sdk.Uint   `json:"nonce"`
+	EthereumSender string     `json:"ethereum_sender"`
+	// InvalidReceiver is the recipient the lock was made to, hex encoded
+	InvalidReceiver string    `json:"invalid_receiver"`
+	Amount          sdk.Coins `json:"amount"`
+	Status          string    `json:"status"`
+}
+
+// NewRefund is a constructor function for a refundable Refund on the final claim of a lock prophecy
+func NewRefund(prophecyID ProphecyID, claim OracleClaim) Refund {
+	return Refund{
+		ProphecyID:      prophecyID,
+		Nonce:           claim.Nonce,
+		EthereumSender:  claim.EthereumSender,
+		InvalidReceiver: claim.InvalidReceiver,
+		Amount:          claim.Amount,
+		Status:          RefundStatusRefundable,
+	}
+}
+
+// RefundSenderPrefix returns the prefix shared by the refunds of the locks made by an ethereum sender
+func RefundSenderPrefix(ethereumSender string) []byte {
+	return append(append([]byte{}, RefundKeyPrefix...), gethCommon.HexToAddress(ethereumSender).Bytes()...)
+}
+
+// GetRefundKey returns the store key of the refund of a lock prophecy. Keys sort by ethereum sender, so the refunds
+// owed to a sender can be found together.
+func GetRefundKey(ethereumSender string, prophecyID ProphecyID) []byte {
+	return append(RefundSenderPrefix(ethereumSender), prophecyID.Key()...)
+}
@@ -0,0 +1,79 @@
+package types
+
+import (
+	"encoding/json"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Reasons a lock on the Ethereum bridge contract can be revoked
+const (
+	RevocationReasonWithdraw = "withdraw"
+	RevocationReasonUnlock   = "unlock"
+)
+
+// EthBridgeRevocation is a validator's claim that a locked item was released back on Ethereum
+type EthBridgeRevocation struct {
+	EthereumChainID       int            `json:"ethereum_chain_id"`
+	BridgeContractAddress string         `json:"bridge_contract_address"`
+	ItemID                string         `json:"item_id"`
+	Nonce                 sdk.Uint       `json:"nonce"`
+	EthereumSender        string         `json:"ethereum_sender"`
+	Validator             sdk.AccAddress `json:"validator"`
+	Reason                string         `json:"reason"`
+}
+
+// NewEthBridgeRevocation is a constructor function for EthBridgeRevocation
+func NewEthBridgeRevocation(ethereumChainID int, bridgeContractAddress string, itemID string, nonce sdk.Uint, ethereumSender string, validator sdk.AccAddress, reason string) EthBridgeRevocation {
+	return EthBridgeRevocation{
+		EthereumChainID:       ethereumChainID,
+		BridgeContractAddress: bridgeContractAddress,
+		ItemID:                itemID,
+		Nonce:                 nonce,
+		EthereumSender:        ethereumSender,
+		Validator:             validator,
+		Reason:                reason,
+	}
+}
+
+// ProphecyID returns the id of the prophecy on the revoked lock
+func (revocation EthBridgeRevocation) ProphecyID() ProphecyID {
+	return NewProphecyID(revocation.EthereumChainID, revocation.BridgeContractAddress, revocation.ItemID)
+}
+
+// IsValidRevocationReason returns true if the reason is one the bridge contract can emit
+func IsValidRevocationReason(reason string) bool {
+	return reason == RevocationReasonWithdraw || reason == RevocationReasonUnlock
+}
+
+// OracleRevocation is the details of how the revocation for each validator will be stored in the oracle
+type OracleRevocation struct {
+	EthereumSender string   `json:"ethereum_sender"`
+	Nonce          sdk.Uint `json:"nonce"`
+	Reason         string   `json:"reason"`
+}
+
+// CreateOracleClaimFromEthRevocation returns the oracle id of the revocation, the validator making it, the claim
+// content, and the id of the lock prophecy which will be revoked once the revocation succeeds
+func CreateOracleClaimFromEthRevocation(cdc *codec.Codec, revocation EthBridgeRevocation) (string, sdk.ValAddress, string, string) {
+	prophecyID := revocation.ProphecyID()
+	claimBytes, _ := json.Marshal(OracleRevocation{EthereumSender: revocation.EthereumSender, Nonce: revocation.Nonce, Reason: revocation.Reason})
+	validator := sdk.ValAddress(revocation.Validator)
+	return string(prophecyID.RevocationKey()), validator, string(claimBytes), string(prophecyID.Key())
+}
+
+// ClawbackAmount returns the part of the minted amount which is still held by the receiver
+func ClawbackAmount(minted sdk.Coins, held sdk.Coins) sdk.Coins {
+	clawback := sdk.Coins{}
+	for _, coin := range minted {
+		amount := held.AmountOf(coin.Denom)
+		if coin.Amount.LT(amount) {
+			amount = coin.Amount
+		}
+		if amount.IsPositive() {
+			clawback = append(clawback, sdk.NewCoin(coin.Denom, amount))
+		}
+	}
+	return clawback.Sort()
+}
@@ -14,11 +14,21 @@ import (
 const (
 	TestAddress            = "cosmos1gn8409qq9hnrxde37kuxwx5hrxpfpv8426szuv"
 	TestValidator          = "cosmos1xdp5tvt7lxh8rf9xx07wy2xlagzhq24ha48xtq"
-	TestNonce              = 0
+	TestEthereumChainID    = 3
+	TestBridgeContract     = "0x3de4ef81Ba6243A60B0a32d3BCeD4173b6EA02bb"
+	TestNonce              = 1
+	TestLargeNonce         = "18446744073709551621"
 	TestEthereumAddress    = "0x7B95B6EC7EbD73572298cEf32Bb54FA408207359"
 	AltTestEthereumAddress = "0x7B95B6EC7EbD73572298cEf32Bb54FA408207344"
 	TestCoins              = "10ethereum"
 	AltTestCoins           = "12ethereum"
+	TestEthereumTxHash     = "0xab85e2ceaa7d100af2f07cac01365f3777153a4e004342dca5db44e731b9d461"
+	TestEthereumItemID     = "0x5a1b5e6c5ee3fc1a9a4a5fc6bb6b1f8e3f8c0d3a9b7e6f5d4c3b2a1908f7e6d5"
+	AltTestEthereumItemID  = "0x0d9c8b7a6f5e4d3c2b1a09f8e7d6c5b4a392817f6e5d4c3b2a190817263544aa"
+	TestEthereumBlockHash  = "0x9f0a3c3b6a2e6e7d1c5b4a3928171605f4e3d2c1b0a99887766554433221100f"
+	TestEthereumBlock      = 100
+	TestEthereumLogIndex   = 2
+	TestInvalidReceiver    = "0x6e656f"
 )
 
 //Ethereum-bridge specific stuff
@@ -33,14 +43,29 @@ func CreateTestEthClaim(t *testing.T, validatorAddress sdk.AccAddress, testEther
 	amount, err2 := sdk.ParseCoins(coins)
 	require.NoError(t, err1)
 	require.NoError(t, err2)
-	ethClaim := NewEthBridgeClaim(TestNonce, testEthereumAddress, testCosmosAddress, validatorAddress, amount)
+	ethClaim := NewEthBridgeClaim(TestEthereumChainID, TestBridgeContract, TestEthereumItemID, sdk.NewUint(TestNonce), testEthereumAddress, testCosmosAddress, validatorAddress, amount, CreateTestEthereumProvenance())
 	return ethClaim
 }
 
+func CreateTestInvalidReceiverMsg(t *testing.T, validatorAddress sdk.AccAddress) MsgMakeInvalidReceiverClaim {
+	amount, err := sdk.ParseCoins(TestCoins)
+	require.NoError(t, err)
+	ethClaim := NewInvalidReceiverClaim(TestEthereumChainID, TestBridgeContract, TestEthereumItemID, sdk.NewUint(TestNonce), TestEthereumAddress, TestInvalidReceiver, validatorAddress, amount, CreateTestEthereumProvenance())
+	return NewMsgMakeInvalidReceiverClaim(ethClaim)
+}
+
+func CreateTestEthereumProvenance() EthereumProvenance {
+	return NewEthereumProvenance(TestEthereumTxHash, TestEthereumBlock, TestEthereumBlockHash, TestEthereumLogIndex)
+}
+
+func CreateTestRevocationMsg(t *testing.T, validatorAddress sdk.AccAddress, reason string) MsgRevokeEthBridgeClaim {
+	revocation := NewEthBridgeRevocation(TestEthereumChainID, TestBridgeContract, TestEthereumItemID, sdk.NewUint(TestNonce), TestEthereumAddress, validatorAddress, reason)
+	return NewMsgRevokeEthBridgeClaim(revocation)
+}
+
 func CreateTestQueryEthProphecyResponse(cdc *codec.Codec, t *testing.T, validatorAddress sdk.AccAddress) QueryEthProphecyResponse {
 	ethBridgeClaim := CreateTestEthClaim(t, validatorAddress, TestEthereumAddress, TestCoins)
-	id, _, _ := CreateOracleClaimFromEthClaim(cdc, ethBridgeClaim)
 	ethBridgeClaims := []EthBridgeClaim{ethBridgeClaim}
-	resp := NewQueryEthProphecyResponse(id, oracle.Status{oracle.PendingStatus, ""}, ethBridgeClaims)
+	resp := NewQueryEthProphecyResponse(ethBridgeClaim.ProphecyID(), oracle.Status{oracle.PendingStatus, ""}, ethBridgeClaims)
 	return resp
 }
@@ -62,12 +62,62 @@ func (k Keeper) GetProphecy(ctx sdk.Context, id string) (types.Prophecy, sdk.Err
 	return deSerializedProphecy, nil
 }
 
+// IterateProphecies calls the callback with each stored prophecy in order of id, until the callback returns true
+func (k Keeper) IterateProphecies(ctx sdk.Context, cb func(prophecy types.Prophecy) (stop bool)) sdk.Error {
+	return k.IteratePropheciesWithPrefix(ctx, nil, cb)
+}
+
+// IteratePropheciesWithPrefix calls the callback with each stored prophecy whose id starts with the prefix, in
+// order of id, until the callback returns true
+func (k Keeper) IteratePropheciesWithPrefix(ctx sdk.Context, prefix []byte, cb func(prophecy types.Prophecy) (stop bool)) sdk.Error {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var dbProphecy types.DBProphecy
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &dbProphecy)
+
+		prophecy, err := dbProphecy.DeserializeFromDB()
+		if err != nil {
+			return types.ErrInternalDB(k.Codespace(), err)
+		}
+		if cb(prophecy) {
+			break
+		}
+	}
+	return nil
+}
+
+// MigrateProphecy moves a stored prophecy from its old id to the id of the given prophecy, replacing its content.
+// It is used when the format of a module's prophecy ids changes, and fails rather than overwrite another prophecy.
+func (k Keeper) MigrateProphecy(ctx sdk.Context, oldID string, prophecy types.Prophecy) sdk.Error {
+	if oldID == "" {
+		return types.ErrInvalidIdentifier(k.Codespace())
+	}
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has([]byte(oldID)) {
+		return types.ErrProphecyNotFound(k.Codespace())
+	}
+	if prophecy.ID != oldID && store.Has([]byte(prophecy.ID)) {
+		return types.ErrProphecyExists(k.Codespace())
+	}
+	err := k.saveProphecy(ctx, prophecy)
+	if err != nil {
+		return err
+	}
+	if prophecy.ID != oldID {
+		store.Delete([]byte(oldID))
+	}
+	return nil
+}
+
 // saveProphecy saves a prophecy with an initial claim
 func (k Keeper) saveProphecy(ctx sdk.Context, prophecy types.Prophecy) sdk.Error {
 	if prophecy.ID == "" {
 		return types.ErrInvalidIdentifier(k.Codespace())
 	}
-	if len(prophecy.ClaimValidators) <= 0 {
+	if len(prophecy.ClaimValidators) <= 0 && prophecy.Status.StatusText != types.RevokedStatusText {
 		return types.ErrNoClaims(k.Codespace())
 	}
 	store := ctx.KVStore(k.storeKey)
@@ -89,7 +139,7 @@ func (k Keeper) ProcessClaim(ctx sdk.Context, id string, validator sdk.ValAddres
 	}
 	prophecy, err := k.GetProphecy(ctx, id)
 	if err == nil {
-		if prophecy.Status.StatusText == types.SuccessStatusText || prophecy.Status.StatusText == types.FailedStatusText {
+		if prophecy.IsFinalized() {
 			return types.Status{}, types.ErrProphecyFinalized(k.Codespace())
 		}
 		if prophecy.ValidatorClaims[validator.String()] != "" {
@@ -111,6 +161,52 @@ func (k Keeper) ProcessClaim(ctx sdk.Context, id string, validator sdk.ValAddres
 	return prophecy.Status, nil
 }
 
+// RevokeProphecy marks a prophecy as revoked so that it accepts no further claims, creating it if no
+// claims have been made yet. It returns the prophecy as it was before being revoked.
+func (k Keeper) RevokeProphecy(ctx sdk.Context, id string) (types.Prophecy, sdk.Error) {
+	prophecy, err := k.GetProphecy(ctx, id)
+	if err != nil {
+		if err.Code() != types.CodeProphecyNotFound {
+			return types.NewEmptyProphecy(), err
+		}
+		prophecy = types.NewProphecy(id)
+	}
+	if prophecy.Status.StatusText == types.RevokedStatusText {
+		return types.NewEmptyProphecy(), types.ErrProphecyFinalized(k.Codespace())
+	}
+	previous := prophecy
+
+	prophecy.Status = types.NewStatus(types.RevokedStatusText, prophecy.Status.FinalClaim)
+	err = k.saveProphecy(ctx, prophecy)
+	if err != nil {
+		return types.NewEmptyProphecy(), err
+	}
+	return previous, nil
+}
+
+// GetValidatorPower returns the tendermint power of a bonded validator, or 0 if the validator is not bonded
+func (k Keeper) GetValidatorPower(ctx sdk.Context, validatorAddress sdk.ValAddress) int64 {
+	if !k.checkActiveValidator(ctx, validatorAddress) {
+		return 0
+	}
+	validator, _ := k.stakeKeeper.GetValidator(ctx, validatorAddress)
+	return validator.GetTendermintPower()
+}
+
+// HasConsensus returns true if the bonded validators among the given ones hold at least the share of the total
+// power needed for a prophecy to succeed
+func (k Keeper) HasConsensus(ctx sdk.Context, validatorAddresses []sdk.ValAddress) bool {
+	power := int64(0)
+	for _, validatorAddress := range validatorAddresses {
+		power += k.GetValidatorPower(ctx, validatorAddress)
+	}
+	totalPower := k.stakeKeeper.GetLastTotalPower(ctx)
+	if !totalPower.IsPositive() {
+		return false
+	}
+	return float64(power)/float64(totalPower.Int64()) >= k.consensusNeeded
+}
+
 func (k Keeper) checkActiveValidator(ctx sdk.Context, validatorAddress sdk.ValAddress) bool {
 	validator, found := k.stakeKeeper.GetValidator(ctx, validatorAddress)
 	if !found {
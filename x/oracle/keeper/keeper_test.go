@@ -4,6 +4,7 @@ import (
 	"strings"
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 	"github.com/swishlabsco/cosmos-ethereum-bridge/x/oracle/types"
 )
@@ -229,3 +230,134 @@ func TestNonValidator(t *testing.T) {
 	require.True(t, strings.Contains(err.Error(), "Claim must be made by actively bonded validator"))
 	require.Equal(t, status.StatusText, "")
 }
+
+func TestHasConsensus(t *testing.T) {
+	ctx, _, keeper, _, validatorAddresses, _ := CreateTestKeepers(t, 0.7, []int64{3, 7})
+	_, otherAddresses := CreateTestAddrs(10)
+
+	require.False(t, keeper.HasConsensus(ctx, nil))
+	require.False(t, keeper.HasConsensus(ctx, validatorAddresses[:1]))
+	require.True(t, keeper.HasConsensus(ctx, validatorAddresses[1:]))
+	require.True(t, keeper.HasConsensus(ctx, validatorAddresses))
+
+	//Addresses which are not bonded validators add no power
+	require.False(t, keeper.HasConsensus(ctx, []sdk.ValAddress{validatorAddresses[0], otherAddresses[9]}))
+}
+
+func TestRevokeProphecy(t *testing.T) {
+	ctx, _, keeper, _, validatorAddresses, _ := CreateTestKeepers(t, 0.7, []int64{3, 7})
+	validator1Pow3 := validatorAddresses[0]
+	validator2Pow7 := validatorAddresses[1]
+
+	//Revoking a pending prophecy returns it as it was and blocks further claims
+	status, err := keeper.ProcessClaim(ctx, types.TestID, validator1Pow3, types.TestString)
+	require.NoError(t, err)
+	require.Equal(t, status.StatusText, types.PendingStatusText)
+
+	previous, err := keeper.RevokeProphecy(ctx, types.TestID)
+	require.NoError(t, err)
+	require.Equal(t, previous.Status.StatusText, types.PendingStatusText)
+
+	prophecy, err := keeper.GetProphecy(ctx, types.TestID)
+	require.NoError(t, err)
+	require.Equal(t, prophecy.Status.StatusText, types.RevokedStatusText)
+
+	_, err = keeper.ProcessClaim(ctx, types.TestID, validator2Pow7, types.TestString)
+	require.Error(t, err)
+	require.True(t, strings.Contains(err.Error(), "Prophecy already finalized"))
+
+	//Revoking twice fails
+	_, err = keeper.RevokeProphecy(ctx, types.TestID)
+	require.Error(t, err)
+
+	//Revoking a prophecy with no claims yet creates it as revoked
+	previous, err = keeper.RevokeProphecy(ctx, types.AlternateTestID)
+	require.NoError(t, err)
+	require.Equal(t, previous.Status.StatusText, types.PendingStatusText)
+
+	_, err = keeper.ProcessClaim(ctx, types.AlternateTestID, validator2Pow7, types.AlternateTestString)
+	require.Error(t, err)
+	require.True(t, strings.Contains(err.Error(), "Prophecy already finalized"))
+}
+
+func TestIterateProphecies(t *testing.T) {
+	ctx, _, keeper, _, validatorAddresses, _ := CreateTestKeepers(t, 0.7, []int64{3, 7})
+	validator1Pow3 := validatorAddresses[0]
+
+	_, err := keeper.ProcessClaim(ctx, types.TestID, validator1Pow3, types.TestString)
+	require.NoError(t, err)
+	_, err = keeper.ProcessClaim(ctx, types.AlternateTestID, validator1Pow3, types.AlternateTestString)
+	require.NoError(t, err)
+
+	var ids []string
+	err = keeper.IterateProphecies(ctx, func(prophecy types.Prophecy) bool {
+		ids = append(ids, prophecy.ID)
+		require.Equal(t, prophecy.Status.StatusText, types.PendingStatusText)
+		return false
+	})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{types.TestID, types.AlternateTestID}, ids)
+
+	//Iteration stops once the callback returns true
+	ids = nil
+	err = keeper.IterateProphecies(ctx, func(prophecy types.Prophecy) bool {
+		ids = append(ids, prophecy.ID)
+		return true
+	})
+	require.NoError(t, err)
+	require.Len(t, ids, 1)
+}
+
+func TestIteratePropheciesWithPrefix(t *testing.T) {
+	ctx, _, keeper, _, validatorAddresses, _ := CreateTestKeepers(t, 0.7, []int64{3, 7})
+	validator1Pow3 := validatorAddresses[0]
+
+	_, err := keeper.ProcessClaim(ctx, types.TestID, validator1Pow3, types.TestString)
+	require.NoError(t, err)
+	_, err = keeper.ProcessClaim(ctx, types.AlternateTestID, validator1Pow3, types.AlternateTestString)
+	require.NoError(t, err)
+
+	var ids []string
+	err = keeper.IteratePropheciesWithPrefix(ctx, []byte("alt"), func(prophecy types.Prophecy) bool {
+		ids = append(ids, prophecy.ID)
+		return false
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{types.AlternateTestID}, ids)
+}
+
+func TestMigrateProphecy(t *testing.T) {
+	ctx, _, keeper, _, validatorAddresses, _ := CreateTestKeepers(t, 0.7, []int64{3, 7})
+	validator1Pow3 := validatorAddresses[0]
+
+	_, err := keeper.ProcessClaim(ctx, types.TestID, validator1Pow3, types.TestString)
+	require.NoError(t, err)
+	_, err = keeper.ProcessClaim(ctx, types.AlternateTestID, validator1Pow3, types.AlternateTestString)
+	require.NoError(t, err)
+
+	prophecy, err := keeper.GetProphecy(ctx, types.TestID)
+	require.NoError(t, err)
+
+	//A prophecy cannot be moved onto another prophecy
+	prophecy.ID = types.AlternateTestID
+	err = keeper.MigrateProphecy(ctx, types.TestID, prophecy)
+	require.Error(t, err)
+	require.Equal(t, types.CodeProphecyExists, err.Code())
+
+	newID := "newOracleID"
+	prophecy.ID = newID
+	prophecy.ValidatorClaims[validator1Pow3.String()] = types.AnotherAlternateTestString
+	err = keeper.MigrateProphecy(ctx, types.TestID, prophecy)
+	require.NoError(t, err)
+
+	_, err = keeper.GetProphecy(ctx, types.TestID)
+	require.Error(t, err)
+	migrated, err := keeper.GetProphecy(ctx, newID)
+	require.NoError(t, err)
+	require.Equal(t, types.AnotherAlternateTestString, migrated.ValidatorClaims[validator1Pow3.String()])
+	require.Equal(t, types.PendingStatusText, migrated.Status.StatusText)
+
+	err = keeper.MigrateProphecy(ctx, types.TestID, prophecy)
+	require.Error(t, err)
+	require.Equal(t, types.CodeProphecyNotFound, err.Code())
+}
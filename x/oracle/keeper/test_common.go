@@ -24,8 +24,16 @@ import (
 	tmtypes "github.com/tendermint/tendermint/types"
 )
 
-// CreateTestKeepers greates an OracleKeeper, AccountKeeper and Context to be used for test input
-func CreateTestKeepers(t *testing.T, consensusNeeded float64, validatorPowers []int64) (sdk.Context, auth.AccountKeeper, Keeper, bank.Keeper, []sdk.ValAddress, sdk.Error) {
+// CreateTestKeepers greates an OracleKeeper, AccountKeeper and Context to be used for test input.
+// Any extra store keys are mounted so that modules built on the oracle can share the Context.
+func CreateTestKeepers(t *testing.T, consensusNeeded float64, validatorPowers []int64, extraKeys ...*sdk.KVStoreKey) (sdk.Context, auth.AccountKeeper, Keeper, bank.Keeper, []sdk.ValAddress, sdk.Error) {
+	ctx, accountKeeper, keeper, bankKeeper, _, valAddresses, err := CreateTestKeepersWithParams(t, consensusNeeded, validatorPowers, extraKeys...)
+	return ctx, accountKeeper, keeper, bankKeeper, valAddresses, err
+}
+
+// CreateTestKeepersWithParams is CreateTestKeepers which also returns the ParamsKeeper, for modules built on the
+// oracle which have params of their own
+func CreateTestKeepersWithParams(t *testing.T, consensusNeeded float64, validatorPowers []int64, extraKeys ...*sdk.KVStoreKey) (sdk.Context, auth.AccountKeeper, Keeper, bank.Keeper, params.Keeper, []sdk.ValAddress, sdk.Error) {
 	keyOracle := sdk.NewKVStoreKey(types.StoreKey)
 	keyAcc := sdk.NewKVStoreKey(auth.StoreKey)
 	keyParams := sdk.NewKVStoreKey(params.StoreKey)
@@ -41,6 +49,9 @@ func CreateTestKeepers(t *testing.T, consensusNeeded float64, validatorPowers []
 	ms.MountStoreWithDB(keyAcc, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
+	for _, key := range extraKeys {
+		ms.MountStoreWithDB(key, sdk.StoreTypeIAVL, db)
+	}
 	err := ms.LoadLatestVersion()
 	require.Nil(t, err)
 
@@ -104,7 +115,7 @@ func CreateTestKeepers(t *testing.T, consensusNeeded float64, validatorPowers []
 		stakingKeeperLib.TestingUpdateValidator(stakingKeeper, ctx, validators[i], true)
 	}
 
-	return ctx, accountKeeper, keeper, bankKeeper, valAddresses, keeperErr
+	return ctx, accountKeeper, keeper, bankKeeper, pk, valAddresses, keeperErr
 }
 
 // nolint: unparam
@@ -26,6 +26,7 @@ const (
 	PendingStatus = types.PendingStatusText
 	SuccessStatus = types.SuccessStatusText
 	FailedStatus  = types.FailedStatusText
+	RevokedStatus = types.RevokedStatusText
 )
 
 const (
@@ -41,4 +42,5 @@ var (
 	ErrProphecyNotFound              = types.ErrProphecyNotFound
 	ErrMinimumConsensusNeededInvalid = types.ErrMinimumConsensusNeededInvalid
 	ErrInvalidIdentifier             = types.ErrInvalidIdentifier
+	ErrProphecyExists                = types.ErrProphecyExists
 )
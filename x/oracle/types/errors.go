@@ -22,6 +22,7 @@ const (
 	CodeInvalidClaim                  CodeType = 7
 	CodeInvalidValidator              CodeType = 8
 	CodeInternalDB                    CodeType = 9
+	CodeProphecyExists                CodeType = 10
 )
 
 func ErrProphecyNotFound(codespace sdk.CodespaceType) sdk.Error {
@@ -56,6 +57,10 @@ func ErrInvalidValidator(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidValidator, "Claim must be made by actively bonded validator")
 }
 
+func ErrProphecyExists(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeProphecyExists, "prophecy with given id already exists")
+}
+
 func ErrInternalDB(codespace sdk.CodespaceType, err error) sdk.Error {
 	return sdk.NewError(codespace, CodeInternalDB, fmt.Sprintf("Internal error serializing/deserializing prophecy: %s", err.Error()))
 }
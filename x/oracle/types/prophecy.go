@@ -11,6 +11,7 @@ import (
 const PendingStatusText = "pending"
 const SuccessStatusText = "success"
 const FailedStatusText = "failed"
+const RevokedStatusText = "revoked"
 
 // Prophecy is a struct that contains all the metadata of an oracle ritual.
 // Claims are indexed by the claim's validator bech32 address and by the claim's json value to allow
@@ -124,6 +125,16 @@ func NewProphecy(id string) Prophecy {
 	}
 }
 
+// IsFinalized returns true once the prophecy can no longer accept claims
+func (prophecy Prophecy) IsFinalized() bool {
+	switch prophecy.Status.StatusText {
+	case SuccessStatusText, FailedStatusText, RevokedStatusText:
+		return true
+	default:
+		return false
+	}
+}
+
 // NewEmptyProphecy returns a blank prophecy, used with errors
 func NewEmptyProphecy() Prophecy {
 	return NewProphecy("")